@@ -0,0 +1,118 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/integrii/flaggy"
+	"go.uber.org/zap"
+
+	"github.com/cartermckinnon/watchclub/internal/backup"
+	"github.com/cartermckinnon/watchclub/internal/cli"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+func NewBackupCommand() cli.Command {
+	bc := backupCommand{
+		c:    flaggy.NewSubcommand("backup"),
+		keep: 7,
+	}
+	bc.c.Description = "Take a snapshot of SQLite storage, which is safe while the server is running"
+	bc.c.String(&bc.storage, "s", "storage", "Storage URI (sqlite://path/to/db)")
+	bc.c.String(&bc.dir, "o", "dir", "Directory to write the snapshot to")
+	bc.c.Int(&bc.keep, "k", "keep", "Number of snapshots to keep in the directory, deleting the oldest (0 keeps them all)")
+	return &bc
+}
+
+type backupCommand struct {
+	c *flaggy.Subcommand
+
+	storage string
+	dir     string
+	keep    int
+}
+
+func (bc *backupCommand) Flaggy() *flaggy.Subcommand {
+	return bc.c
+}
+
+func (bc *backupCommand) Run(logger *zap.Logger, opts *cli.GlobalOptions) error {
+	if bc.dir == "" {
+		return errors.New("--dir is required")
+	}
+	dbPath, ok := storage.SQLiteFile(bc.storage)
+	if !ok {
+		return fmt.Errorf("only SQLite storage can be backed up: %s", bc.storage)
+	}
+	// the server may be using the database, so it's opened as it is, without migrating it
+	backuper, err := storage.OpenSQLiteBackuper(context.Background(), dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open storage: %w", err)
+	}
+	defer backuper.Close()
+
+	path, err := backup.Snapshot(context.Background(), backuper, bc.dir, bc.keep, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	logger.Info("Took snapshot", zap.String("path", path))
+	return nil
+}
+
+func NewRestoreCommand() cli.Command {
+	rc := restoreCommand{
+		c: flaggy.NewSubcommand("restore"),
+	}
+	rc.c.Description = "Replace SQLite storage with a snapshot; stop the server first"
+	rc.c.String(&rc.storage, "s", "storage", "Storage URI to restore to (sqlite://path/to/db)")
+	rc.c.String(&rc.snapshot, "f", "from", "Snapshot to restore, or a directory to restore its newest snapshot")
+	rc.c.Bool(&rc.force, "", "force", "Replace the database if it already exists")
+	return &rc
+}
+
+type restoreCommand struct {
+	c *flaggy.Subcommand
+
+	storage  string
+	snapshot string
+	force    bool
+}
+
+func (rc *restoreCommand) Flaggy() *flaggy.Subcommand {
+	return rc.c
+}
+
+func (rc *restoreCommand) Run(logger *zap.Logger, opts *cli.GlobalOptions) error {
+	dbPath, ok := storage.SQLiteFile(rc.storage)
+	if !ok {
+		return fmt.Errorf("only SQLite storage can be restored: %s", rc.storage)
+	}
+	if rc.snapshot == "" {
+		return errors.New("--from is required")
+	}
+
+	snapshot := rc.snapshot
+	if info, err := os.Stat(snapshot); err == nil && info.IsDir() {
+		snapshots, err := backup.List(snapshot)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return fmt.Errorf("no snapshots in %s", snapshot)
+		}
+		snapshot = snapshots[len(snapshots)-1]
+	}
+
+	if _, err := os.Stat(dbPath); err == nil && !rc.force {
+		return fmt.Errorf("%s already exists; use --force to replace it", dbPath)
+	}
+
+	if err := backup.Restore(context.Background(), snapshot, dbPath); err != nil {
+		return fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+	logger.Info("Restored snapshot", zap.String("snapshot", snapshot), zap.String("database", dbPath))
+	return nil
+}
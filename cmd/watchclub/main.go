@@ -1,6 +1,10 @@
 package main
 
 import (
+	"github.com/cartermckinnon/watchclub/cmd/watchclub/archive"
+	"github.com/cartermckinnon/watchclub/cmd/watchclub/backup"
+	"github.com/cartermckinnon/watchclub/cmd/watchclub/check"
+	"github.com/cartermckinnon/watchclub/cmd/watchclub/keys"
 	"github.com/cartermckinnon/watchclub/cmd/watchclub/server"
 	"github.com/cartermckinnon/watchclub/internal/cli"
 )
@@ -12,6 +16,12 @@ func main() {
 		AdditionalHelp: "http://github.com/cartermckinnon/watchclub",
 		Commands: []cli.Command{
 			server.NewServerCommand(),
+			check.NewCheckCommand(),
+			backup.NewBackupCommand(),
+			backup.NewRestoreCommand(),
+			archive.NewExportCommand(),
+			archive.NewImportCommand(),
+			keys.NewRotateKeysCommand(),
 		},
 	}
 	m.Run()
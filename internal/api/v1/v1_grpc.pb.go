@@ -44,10 +44,40 @@ type WatchClubServiceClient interface {
 	SendLoginEmail(ctx context.Context, in *SendLoginEmailRequest, opts ...grpc.CallOption) (*SendLoginEmailResponse, error)
 	// GetClubCalendar generates an ICS calendar file for a club's schedule
 	GetClubCalendar(ctx context.Context, in *GetClubCalendarRequest, opts ...grpc.CallOption) (*GetClubCalendarResponse, error)
-	// ListUserClubs lists all clubs a user is a member of
+	// ListUserClubs lists the clubs a user is a member of
 	ListUserClubs(ctx context.Context, in *ListUserClubsRequest, opts ...grpc.CallOption) (*ListUserClubsResponse, error)
-	// DeleteClub deletes a club
+	// ListPicks lists a club's picks
+	ListPicks(ctx context.Context, in *ListPicksRequest, opts ...grpc.CallOption) (*ListPicksResponse, error)
+	// DeleteClub deletes a club, which can be restored until it's purged
 	DeleteClub(ctx context.Context, in *DeleteClubRequest, opts ...grpc.CallOption) (*DeleteClubResponse, error)
+	// ListDeletedClubs lists a user's deleted clubs that haven't been purged yet
+	ListDeletedClubs(ctx context.Context, in *ListDeletedClubsRequest, opts ...grpc.CallOption) (*ListDeletedClubsResponse, error)
+	// RestoreClub restores a deleted club, putting its schedule back in members' calendars
+	RestoreClub(ctx context.Context, in *RestoreClubRequest, opts ...grpc.CallOption) (*RestoreClubResponse, error)
+	// ListDeletedPicks lists a user's deleted picks in a club that haven't been purged yet
+	ListDeletedPicks(ctx context.Context, in *ListDeletedPicksRequest, opts ...grpc.CallOption) (*ListDeletedPicksResponse, error)
+	// RestorePick restores a deleted pick (only allowed before the club starts)
+	RestorePick(ctx context.Context, in *RestorePickRequest, opts ...grpc.CallOption) (*RestorePickResponse, error)
+	// MoveScheduledPick moves a scheduled pick to a new date, updating members' calendars
+	MoveScheduledPick(ctx context.Context, in *MoveScheduledPickRequest, opts ...grpc.CallOption) (*MoveScheduledPickResponse, error)
+	// DeleteScheduledPick removes a pick from a club's schedule, cancelling it in members' calendars
+	DeleteScheduledPick(ctx context.Context, in *DeleteScheduledPickRequest, opts ...grpc.CallOption) (*DeleteScheduledPickResponse, error)
+	// ResetClub discards a club's schedule (cancelling it in members' calendars) so that it can be started again
+	ResetClub(ctx context.Context, in *ResetClubRequest, opts ...grpc.CallOption) (*ResetClubResponse, error)
+	// GetNotificationPreferences gets a user's notification preferences
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences replaces a user's notification preferences
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
+	// GetCalendarFeed gets the URLs a user can subscribe to in their calendar app
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error)
+	// RotateCalendarFeedToken replaces a user's calendar feed token, revoking the old URLs
+	RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RotateCalendarFeedTokenResponse, error)
+	// CreateAppPassword creates a password for signing in to the CalDAV endpoint
+	CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error)
+	// ListAppPasswords lists a user's app passwords
+	ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...grpc.CallOption) (*ListAppPasswordsResponse, error)
+	// DeleteAppPassword revokes an app password
+	DeleteAppPassword(ctx context.Context, in *DeleteAppPasswordRequest, opts ...grpc.CallOption) (*DeleteAppPasswordResponse, error)
 }
 
 type watchClubServiceClient struct {
@@ -166,6 +196,15 @@ func (c *watchClubServiceClient) ListUserClubs(ctx context.Context, in *ListUser
 	return out, nil
 }
 
+func (c *watchClubServiceClient) ListPicks(ctx context.Context, in *ListPicksRequest, opts ...grpc.CallOption) (*ListPicksResponse, error) {
+	out := new(ListPicksResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/ListPicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *watchClubServiceClient) DeleteClub(ctx context.Context, in *DeleteClubRequest, opts ...grpc.CallOption) (*DeleteClubResponse, error) {
 	out := new(DeleteClubResponse)
 	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/DeleteClub", in, out, opts...)
@@ -175,6 +214,132 @@ func (c *watchClubServiceClient) DeleteClub(ctx context.Context, in *DeleteClubR
 	return out, nil
 }
 
+func (c *watchClubServiceClient) ListDeletedClubs(ctx context.Context, in *ListDeletedClubsRequest, opts ...grpc.CallOption) (*ListDeletedClubsResponse, error) {
+	out := new(ListDeletedClubsResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/ListDeletedClubs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) RestoreClub(ctx context.Context, in *RestoreClubRequest, opts ...grpc.CallOption) (*RestoreClubResponse, error) {
+	out := new(RestoreClubResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/RestoreClub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) ListDeletedPicks(ctx context.Context, in *ListDeletedPicksRequest, opts ...grpc.CallOption) (*ListDeletedPicksResponse, error) {
+	out := new(ListDeletedPicksResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/ListDeletedPicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) RestorePick(ctx context.Context, in *RestorePickRequest, opts ...grpc.CallOption) (*RestorePickResponse, error) {
+	out := new(RestorePickResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/RestorePick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) MoveScheduledPick(ctx context.Context, in *MoveScheduledPickRequest, opts ...grpc.CallOption) (*MoveScheduledPickResponse, error) {
+	out := new(MoveScheduledPickResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/MoveScheduledPick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) DeleteScheduledPick(ctx context.Context, in *DeleteScheduledPickRequest, opts ...grpc.CallOption) (*DeleteScheduledPickResponse, error) {
+	out := new(DeleteScheduledPickResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/DeleteScheduledPick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) ResetClub(ctx context.Context, in *ResetClubRequest, opts ...grpc.CallOption) (*ResetClubResponse, error) {
+	out := new(ResetClubResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/ResetClub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/GetNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/UpdateNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error) {
+	out := new(GetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/GetCalendarFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RotateCalendarFeedTokenResponse, error) {
+	out := new(RotateCalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/RotateCalendarFeedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error) {
+	out := new(CreateAppPasswordResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/CreateAppPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...grpc.CallOption) (*ListAppPasswordsResponse, error) {
+	out := new(ListAppPasswordsResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/ListAppPasswords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchClubServiceClient) DeleteAppPassword(ctx context.Context, in *DeleteAppPasswordRequest, opts ...grpc.CallOption) (*DeleteAppPasswordResponse, error) {
+	out := new(DeleteAppPasswordResponse)
+	err := c.cc.Invoke(ctx, "/watchclub.WatchClubService/DeleteAppPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchClubServiceServer is the server API for WatchClubService service.
 // All implementations must embed UnimplementedWatchClubServiceServer
 // for forward compatibility
@@ -201,10 +366,40 @@ type WatchClubServiceServer interface {
 	SendLoginEmail(context.Context, *SendLoginEmailRequest) (*SendLoginEmailResponse, error)
 	// GetClubCalendar generates an ICS calendar file for a club's schedule
 	GetClubCalendar(context.Context, *GetClubCalendarRequest) (*GetClubCalendarResponse, error)
-	// ListUserClubs lists all clubs a user is a member of
+	// ListUserClubs lists the clubs a user is a member of
 	ListUserClubs(context.Context, *ListUserClubsRequest) (*ListUserClubsResponse, error)
-	// DeleteClub deletes a club
+	// ListPicks lists a club's picks
+	ListPicks(context.Context, *ListPicksRequest) (*ListPicksResponse, error)
+	// DeleteClub deletes a club, which can be restored until it's purged
 	DeleteClub(context.Context, *DeleteClubRequest) (*DeleteClubResponse, error)
+	// ListDeletedClubs lists a user's deleted clubs that haven't been purged yet
+	ListDeletedClubs(context.Context, *ListDeletedClubsRequest) (*ListDeletedClubsResponse, error)
+	// RestoreClub restores a deleted club, putting its schedule back in members' calendars
+	RestoreClub(context.Context, *RestoreClubRequest) (*RestoreClubResponse, error)
+	// ListDeletedPicks lists a user's deleted picks in a club that haven't been purged yet
+	ListDeletedPicks(context.Context, *ListDeletedPicksRequest) (*ListDeletedPicksResponse, error)
+	// RestorePick restores a deleted pick (only allowed before the club starts)
+	RestorePick(context.Context, *RestorePickRequest) (*RestorePickResponse, error)
+	// MoveScheduledPick moves a scheduled pick to a new date, updating members' calendars
+	MoveScheduledPick(context.Context, *MoveScheduledPickRequest) (*MoveScheduledPickResponse, error)
+	// DeleteScheduledPick removes a pick from a club's schedule, cancelling it in members' calendars
+	DeleteScheduledPick(context.Context, *DeleteScheduledPickRequest) (*DeleteScheduledPickResponse, error)
+	// ResetClub discards a club's schedule (cancelling it in members' calendars) so that it can be started again
+	ResetClub(context.Context, *ResetClubRequest) (*ResetClubResponse, error)
+	// GetNotificationPreferences gets a user's notification preferences
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences replaces a user's notification preferences
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	// GetCalendarFeed gets the URLs a user can subscribe to in their calendar app
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
+	// RotateCalendarFeedToken replaces a user's calendar feed token, revoking the old URLs
+	RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*RotateCalendarFeedTokenResponse, error)
+	// CreateAppPassword creates a password for signing in to the CalDAV endpoint
+	CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error)
+	// ListAppPasswords lists a user's app passwords
+	ListAppPasswords(context.Context, *ListAppPasswordsRequest) (*ListAppPasswordsResponse, error)
+	// DeleteAppPassword revokes an app password
+	DeleteAppPassword(context.Context, *DeleteAppPasswordRequest) (*DeleteAppPasswordResponse, error)
 	mustEmbedUnimplementedWatchClubServiceServer()
 }
 
@@ -248,9 +443,54 @@ func (UnimplementedWatchClubServiceServer) GetClubCalendar(context.Context, *Get
 func (UnimplementedWatchClubServiceServer) ListUserClubs(context.Context, *ListUserClubsRequest) (*ListUserClubsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserClubs not implemented")
 }
+func (UnimplementedWatchClubServiceServer) ListPicks(context.Context, *ListPicksRequest) (*ListPicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPicks not implemented")
+}
 func (UnimplementedWatchClubServiceServer) DeleteClub(context.Context, *DeleteClubRequest) (*DeleteClubResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClub not implemented")
 }
+func (UnimplementedWatchClubServiceServer) ListDeletedClubs(context.Context, *ListDeletedClubsRequest) (*ListDeletedClubsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedClubs not implemented")
+}
+func (UnimplementedWatchClubServiceServer) RestoreClub(context.Context, *RestoreClubRequest) (*RestoreClubResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreClub not implemented")
+}
+func (UnimplementedWatchClubServiceServer) ListDeletedPicks(context.Context, *ListDeletedPicksRequest) (*ListDeletedPicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPicks not implemented")
+}
+func (UnimplementedWatchClubServiceServer) RestorePick(context.Context, *RestorePickRequest) (*RestorePickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePick not implemented")
+}
+func (UnimplementedWatchClubServiceServer) MoveScheduledPick(context.Context, *MoveScheduledPickRequest) (*MoveScheduledPickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveScheduledPick not implemented")
+}
+func (UnimplementedWatchClubServiceServer) DeleteScheduledPick(context.Context, *DeleteScheduledPickRequest) (*DeleteScheduledPickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledPick not implemented")
+}
+func (UnimplementedWatchClubServiceServer) ResetClub(context.Context, *ResetClubRequest) (*ResetClubResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetClub not implemented")
+}
+func (UnimplementedWatchClubServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedWatchClubServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedWatchClubServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedWatchClubServiceServer) RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*RotateCalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCalendarFeedToken not implemented")
+}
+func (UnimplementedWatchClubServiceServer) CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppPassword not implemented")
+}
+func (UnimplementedWatchClubServiceServer) ListAppPasswords(context.Context, *ListAppPasswordsRequest) (*ListAppPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppPasswords not implemented")
+}
+func (UnimplementedWatchClubServiceServer) DeleteAppPassword(context.Context, *DeleteAppPasswordRequest) (*DeleteAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppPassword not implemented")
+}
 func (UnimplementedWatchClubServiceServer) mustEmbedUnimplementedWatchClubServiceServer() {}
 
 // UnsafeWatchClubServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -480,6 +720,24 @@ func _WatchClubService_ListUserClubs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchClubService_ListPicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).ListPicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/ListPicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).ListPicks(ctx, req.(*ListPicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WatchClubService_DeleteClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteClubRequest)
 	if err := dec(in); err != nil {
@@ -498,6 +756,258 @@ func _WatchClubService_DeleteClub_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchClubService_ListDeletedClubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedClubsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).ListDeletedClubs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/ListDeletedClubs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).ListDeletedClubs(ctx, req.(*ListDeletedClubsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_RestoreClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).RestoreClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/RestoreClub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).RestoreClub(ctx, req.(*RestoreClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_ListDeletedPicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).ListDeletedPicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/ListDeletedPicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).ListDeletedPicks(ctx, req.(*ListDeletedPicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_RestorePick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).RestorePick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/RestorePick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).RestorePick(ctx, req.(*RestorePickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_MoveScheduledPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveScheduledPickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).MoveScheduledPick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/MoveScheduledPick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).MoveScheduledPick(ctx, req.(*MoveScheduledPickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_DeleteScheduledPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledPickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).DeleteScheduledPick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/DeleteScheduledPick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).DeleteScheduledPick(ctx, req.(*DeleteScheduledPickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_ResetClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).ResetClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/ResetClub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).ResetClub(ctx, req.(*ResetClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/GetNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/UpdateNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/GetCalendarFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_RotateCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).RotateCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/RotateCalendarFeedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).RotateCalendarFeedToken(ctx, req.(*RotateCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_CreateAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).CreateAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/CreateAppPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).CreateAppPassword(ctx, req.(*CreateAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_ListAppPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppPasswordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).ListAppPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/ListAppPasswords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).ListAppPasswords(ctx, req.(*ListAppPasswordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchClubService_DeleteAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchClubServiceServer).DeleteAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchclub.WatchClubService/DeleteAppPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchClubServiceServer).DeleteAppPassword(ctx, req.(*DeleteAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WatchClubService_ServiceDesc is the grpc.ServiceDesc for WatchClubService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -553,10 +1063,70 @@ var WatchClubService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUserClubs",
 			Handler:    _WatchClubService_ListUserClubs_Handler,
 		},
+		{
+			MethodName: "ListPicks",
+			Handler:    _WatchClubService_ListPicks_Handler,
+		},
 		{
 			MethodName: "DeleteClub",
 			Handler:    _WatchClubService_DeleteClub_Handler,
 		},
+		{
+			MethodName: "ListDeletedClubs",
+			Handler:    _WatchClubService_ListDeletedClubs_Handler,
+		},
+		{
+			MethodName: "RestoreClub",
+			Handler:    _WatchClubService_RestoreClub_Handler,
+		},
+		{
+			MethodName: "ListDeletedPicks",
+			Handler:    _WatchClubService_ListDeletedPicks_Handler,
+		},
+		{
+			MethodName: "RestorePick",
+			Handler:    _WatchClubService_RestorePick_Handler,
+		},
+		{
+			MethodName: "MoveScheduledPick",
+			Handler:    _WatchClubService_MoveScheduledPick_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledPick",
+			Handler:    _WatchClubService_DeleteScheduledPick_Handler,
+		},
+		{
+			MethodName: "ResetClub",
+			Handler:    _WatchClubService_ResetClub_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _WatchClubService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _WatchClubService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _WatchClubService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeedToken",
+			Handler:    _WatchClubService_RotateCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "CreateAppPassword",
+			Handler:    _WatchClubService_CreateAppPassword_Handler,
+		},
+		{
+			MethodName: "ListAppPasswords",
+			Handler:    _WatchClubService_ListAppPasswords_Handler,
+		},
+		{
+			MethodName: "DeleteAppPassword",
+			Handler:    _WatchClubService_DeleteAppPassword_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1.proto",
@@ -0,0 +1,383 @@
+// Package archive exports storage to a portable archive, and imports it into any storage.
+//
+// An archive is JSON lines: a header, then one line per record in the order records can be created in, so that every
+// record comes after the records it refers to. Records are protojson, so that archives survive changes to the backends.
+// Deleted clubs and picks aren't archived, even while they can still be restored.
+//
+//	{"format":"watchclub-archive","version":1,"watchclub_version":"v1.2.3","exported_at":"2026-03-01T12:00:00Z"}
+//	{"type":"user","record":{"id":"jo","name":"Jo","email":"jo@example.com"}}
+//	{"type":"club","record":{"id":"movie-night","name":"Movie Night","memberIds":["jo"]}}
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+	"github.com/cartermckinnon/watchclub/internal/version"
+)
+
+const (
+	// Format identifies watchclub archives
+	Format = "watchclub-archive"
+	// Version is the version of the archive format, which is incremented when older versions can't import it
+	Version = 1
+)
+
+// Types of records, in the order they're archived
+const (
+	TypeUser                    = "user"
+	TypeClub                    = "club"
+	TypePick                    = "pick"
+	TypeScheduledPick           = "scheduled_pick"
+	TypeNotificationPreferences = "notification_preferences"
+	TypeCalendarFeed            = "calendar_feed"
+	TypeAppPassword             = "app_password"
+)
+
+// maxLineSize is the longest line an archive can have
+const maxLineSize = 16 << 20
+
+// Header is the first line of an archive
+type Header struct {
+	Format           string    `json:"format"`
+	Version          int       `json:"version"`
+	WatchclubVersion string    `json:"watchclub_version"`
+	ExportedAt       time.Time `json:"exported_at"`
+}
+
+// Summary counts the records of each type in an archive
+type Summary map[string]int
+
+type line struct {
+	Type   string          `json:"type"`
+	Record json.RawMessage `json:"record"`
+}
+
+// Export writes every record in the storage to an archive, except deleted clubs and picks, along with the picks and
+// schedules of deleted clubs
+func Export(ctx context.Context, s storage.Storage, w io.Writer, now time.Time) (Summary, error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(Header{Format: Format, Version: Version, WatchclubVersion: version.Version, ExportedAt: now.UTC()}); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	summary := Summary{}
+	write := func(recordType string, record proto.Message) error {
+		data, err := protojson.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", recordType, err)
+		}
+		if err := enc.Encode(line{Type: recordType, Record: data}); err != nil {
+			return fmt.Errorf("failed to write %s: %w", recordType, err)
+		}
+		summary[recordType]++
+		return nil
+	}
+
+	users, err := s.ListUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	for _, user := range users {
+		if err := write(TypeUser, user); err != nil {
+			return nil, err
+		}
+	}
+
+	clubs, err := s.ListClubs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clubs: %w", err)
+	}
+	clubIDs := make([]string, 0, len(clubs))
+	for _, club := range clubs {
+		if err := write(TypeClub, club); err != nil {
+			return nil, err
+		}
+		clubIDs = append(clubIDs, club.Id)
+	}
+
+	picks, err := s.ListPicksForClubs(ctx, clubIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list picks: %w", err)
+	}
+	for _, pick := range picks {
+		if err := write(TypePick, pick); err != nil {
+			return nil, err
+		}
+	}
+
+	assignments, err := s.ListScheduledPicksForClubs(ctx, clubIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled picks: %w", err)
+	}
+	for _, assignment := range assignments {
+		if err := write(TypeScheduledPick, assignment); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, user := range users {
+		prefs, err := s.GetNotificationPreferences(ctx, user.Id)
+		if err == nil {
+			err = write(TypeNotificationPreferences, prefs)
+		}
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("failed to export notification preferences: %w", err)
+		}
+
+		feed, err := s.GetCalendarFeed(ctx, user.Id)
+		if err == nil {
+			err = write(TypeCalendarFeed, feed)
+		}
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("failed to export calendar feed: %w", err)
+		}
+
+		appPasswords, err := s.ListAppPasswords(ctx, user.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list app passwords: %w", err)
+		}
+		for _, appPassword := range appPasswords {
+			if err := write(TypeAppPassword, appPassword); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return summary, nil
+}
+
+// Import reads an archive, checking that every record refers to records before it, and creates its records in the
+// storage. With dryRun, the archive is only checked. Records are created as they're read, so an archive that fails
+// part way through is partially imported; check it with dryRun first.
+func Import(ctx context.Context, r io.Reader, s storage.Storage, dryRun bool) (Summary, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read header: %w", err)
+		}
+		return nil, errors.New("archive is empty")
+	}
+	var header Header
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.Format != Format {
+		return nil, errors.New("not a watchclub archive")
+	}
+	if header.Version > Version {
+		return nil, fmt.Errorf("archive version %d is newer than this version of watchclub supports (%d)", header.Version, Version)
+	}
+
+	v := newVerifier()
+	summary := Summary{}
+	for n := 2; scanner.Scan(); n++ {
+		record, recordType, err := decode(scanner.Bytes())
+		if err == nil {
+			err = v.check(record)
+		}
+		if err == nil && !dryRun {
+			err = create(ctx, s, record)
+		}
+		if err != nil {
+			return summary, fmt.Errorf("line %d: %w", n, err)
+		}
+		summary[recordType]++
+	}
+	if err := scanner.Err(); err != nil {
+		return summary, fmt.Errorf("failed to read archive: %w", err)
+	}
+	return summary, nil
+}
+
+// decode parses a line of an archive into its record
+func decode(data []byte) (proto.Message, string, error) {
+	var l line
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, "", fmt.Errorf("invalid record: %w", err)
+	}
+	var record proto.Message
+	switch l.Type {
+	case TypeUser:
+		record = &v1.User{}
+	case TypeClub:
+		record = &v1.Club{}
+	case TypePick:
+		record = &v1.Pick{}
+	case TypeScheduledPick:
+		record = &v1.ScheduledPick{}
+	case TypeNotificationPreferences:
+		record = &v1.NotificationPreferences{}
+	case TypeCalendarFeed:
+		record = &v1.CalendarFeed{}
+	case TypeAppPassword:
+		record = &v1.AppPassword{}
+	default:
+		return nil, "", fmt.Errorf("unknown record type: %q", l.Type)
+	}
+	if err := protojson.Unmarshal(l.Record, record); err != nil {
+		return nil, "", fmt.Errorf("invalid %s: %w", l.Type, err)
+	}
+	return record, l.Type, nil
+}
+
+// create creates a record in the storage
+func create(ctx context.Context, s storage.Storage, record proto.Message) error {
+	switch record := record.(type) {
+	case *v1.User:
+		return s.CreateUser(ctx, record)
+	case *v1.Club:
+		return s.CreateClub(ctx, record)
+	case *v1.Pick:
+		return s.CreatePick(ctx, record)
+	case *v1.ScheduledPick:
+		return s.CreateScheduledPick(ctx, record)
+	case *v1.NotificationPreferences:
+		return s.PutNotificationPreferences(ctx, record)
+	case *v1.CalendarFeed:
+		return s.PutCalendarFeed(ctx, record)
+	case *v1.AppPassword:
+		return s.CreateAppPassword(ctx, record)
+	}
+	return fmt.Errorf("unsupported record: %T", record)
+}
+
+// verifier checks the referential integrity of an archive, one record at a time
+type verifier struct {
+	users        map[string]bool
+	emails       map[string]bool
+	clubs        map[string]bool
+	picks        map[string]string // club of each pick
+	scheduled    map[string]bool
+	prefs        map[string]bool
+	feeds        map[string]bool
+	feedTokens   map[string]bool
+	appPasswords map[string]bool
+}
+
+func newVerifier() *verifier {
+	return &verifier{
+		users:        make(map[string]bool),
+		emails:       make(map[string]bool),
+		clubs:        make(map[string]bool),
+		picks:        make(map[string]string),
+		scheduled:    make(map[string]bool),
+		prefs:        make(map[string]bool),
+		feeds:        make(map[string]bool),
+		feedTokens:   make(map[string]bool),
+		appPasswords: make(map[string]bool),
+	}
+}
+
+// check checks that a record is unique and refers to records before it, and remembers it
+func (v *verifier) check(record proto.Message) error {
+	switch record := record.(type) {
+	case *v1.User:
+		if err := unique("user", record.Id, v.users); err != nil {
+			return err
+		}
+		if v.emails[record.Email] {
+			return fmt.Errorf("user %s: email is already used: %s", record.Id, record.Email)
+		}
+		v.emails[record.Email] = true
+	case *v1.Club:
+		if err := unique("club", record.Id, v.clubs); err != nil {
+			return err
+		}
+		members := make(map[string]bool, len(record.MemberIds))
+		for _, memberID := range record.MemberIds {
+			if !v.users[memberID] {
+				return fmt.Errorf("club %s: member %s isn't in the archive before it", record.Id, memberID)
+			}
+			if members[memberID] {
+				return fmt.Errorf("club %s: member %s is repeated", record.Id, memberID)
+			}
+			members[memberID] = true
+		}
+	case *v1.Pick:
+		if record.Id == "" {
+			return errors.New("pick has no ID")
+		}
+		if _, ok := v.picks[record.Id]; ok {
+			return fmt.Errorf("pick %s is repeated", record.Id)
+		}
+		if !v.clubs[record.ClubId] {
+			return fmt.Errorf("pick %s: club %s isn't in the archive before it", record.Id, record.ClubId)
+		}
+		if !v.users[record.UserId] {
+			return fmt.Errorf("pick %s: user %s isn't in the archive before it", record.Id, record.UserId)
+		}
+		v.picks[record.Id] = record.ClubId
+	case *v1.ScheduledPick:
+		if err := unique("scheduled pick", record.Id, v.scheduled); err != nil {
+			return err
+		}
+		if !v.clubs[record.ClubId] {
+			return fmt.Errorf("scheduled pick %s: club %s isn't in the archive before it", record.Id, record.ClubId)
+		}
+		if record.Pick == nil {
+			return fmt.Errorf("scheduled pick %s has no pick", record.Id)
+		}
+		if clubID, ok := v.picks[record.Pick.Id]; !ok || clubID != record.ClubId {
+			return fmt.Errorf("scheduled pick %s: pick %s of club %s isn't in the archive before it", record.Id, record.Pick.Id, record.ClubId)
+		}
+	case *v1.NotificationPreferences:
+		if err := v.userRecord("notification preferences", record.UserId, v.prefs); err != nil {
+			return err
+		}
+	case *v1.CalendarFeed:
+		if err := v.userRecord("calendar feed", record.UserId, v.feeds); err != nil {
+			return err
+		}
+		if v.feedTokens[record.Token] {
+			return fmt.Errorf("calendar feed of user %s: token is already used", record.UserId)
+		}
+		v.feedTokens[record.Token] = true
+	case *v1.AppPassword:
+		if err := unique("app password", record.Id, v.appPasswords); err != nil {
+			return err
+		}
+		if !v.users[record.UserId] {
+			return fmt.Errorf("app password %s: user %s isn't in the archive before it", record.Id, record.UserId)
+		}
+	default:
+		return fmt.Errorf("unsupported record: %T", record)
+	}
+	return nil
+}
+
+// unique checks that a record has an ID that hasn't been seen, and remembers it
+func unique(entity string, id string, seen map[string]bool) error {
+	if id == "" {
+		return fmt.Errorf("%s has no ID", entity)
+	}
+	if seen[id] {
+		return fmt.Errorf("%s %s is repeated", entity, id)
+	}
+	seen[id] = true
+	return nil
+}
+
+// userRecord checks a record that each user has at most one of, and remembers it
+func (v *verifier) userRecord(entity string, userID string, seen map[string]bool) error {
+	if !v.users[userID] {
+		return fmt.Errorf("%s: user %s isn't in the archive before it", entity, userID)
+	}
+	if seen[userID] {
+		return fmt.Errorf("%s of user %s is repeated", entity, userID)
+	}
+	seen[userID] = true
+	return nil
+}
@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+var exportedAt = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// fill creates one of every kind of record
+func fill(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	now := timestamppb.New(exportedAt)
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com", CreatedAt: now}))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"sam", "jo"}, Started: true, CreatedAt: now}))
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", Year: 1995, CreatedAt: now}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "s1", ClubId: "club", SequenceNumber: 1, StartDate: now, Pick: pick, CalendarUid: "s1@watchclub"}))
+	require.NoError(t, s.PutNotificationPreferences(ctx, &v1.NotificationPreferences{UserId: "jo", DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_DIGESTS}}))
+	require.NoError(t, s.PutCalendarFeed(ctx, &v1.CalendarFeed{UserId: "jo", Token: "token", CreatedAt: now}))
+	require.NoError(t, s.CreateAppPassword(ctx, &v1.AppPassword{Id: "pw", UserId: "sam", Name: "Phone", PasswordHash: "hash", CreatedAt: now}))
+}
+
+func Test_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	memory := storage.NewMemoryStorage()
+	fill(t, memory)
+
+	// deleted clubs and picks are left out
+	require.NoError(t, memory.CreateClub(ctx, &v1.Club{Id: "deleted", Name: "Deleted", MemberIds: []string{"jo"}}))
+	require.NoError(t, memory.CreatePick(ctx, &v1.Pick{Id: "alien", ClubId: "deleted", UserId: "jo", Title: "Alien"}))
+	require.NoError(t, memory.DeleteClub(ctx, "deleted"))
+	require.NoError(t, memory.CreatePick(ctx, &v1.Pick{Id: "clue", ClubId: "club", UserId: "sam", Title: "Clue"}))
+	require.NoError(t, memory.DeletePick(ctx, "clue"))
+
+	var exported bytes.Buffer
+	summary, err := Export(ctx, memory, &exported, exportedAt)
+	require.NoError(t, err)
+	assert.Equal(t, Summary{
+		TypeUser: 2, TypeClub: 1, TypePick: 1, TypeScheduledPick: 1,
+		TypeNotificationPreferences: 1, TypeCalendarFeed: 1, TypeAppPassword: 1,
+	}, summary)
+	assert.True(t, strings.HasPrefix(exported.String(), `{"format":"watchclub-archive","version":1,`))
+
+	sqlite, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "watchclub.db"))
+	require.NoError(t, err)
+
+	// a dry run doesn't write anything
+	dryRun, err := Import(ctx, bytes.NewReader(exported.Bytes()), sqlite, true)
+	require.NoError(t, err)
+	assert.Equal(t, summary, dryRun)
+	users, err := sqlite.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	imported, err := Import(ctx, bytes.NewReader(exported.Bytes()), sqlite, false)
+	require.NoError(t, err)
+	assert.Equal(t, summary, imported)
+
+	// exporting the imported storage gets the same archive
+	var reexported bytes.Buffer
+	_, err = Export(ctx, sqlite, &reexported, exportedAt)
+	require.NoError(t, err)
+	assert.Equal(t, exported.String(), reexported.String())
+}
+
+func Test_ImportChecksIntegrity(t *testing.T) {
+	ctx := context.Background()
+	header := `{"format":"watchclub-archive","version":1}` + "\n"
+	tests := []struct {
+		name    string
+		archive string
+		err     string
+	}{
+		{"empty", "", "archive is empty"},
+		{"not an archive", `{"hello":"world"}`, "not a watchclub archive"},
+		{"newer version", `{"format":"watchclub-archive","version":2}`, "newer than this version"},
+		{"unknown type", header + `{"type":"movie","record":{}}`, `line 2: unknown record type: "movie"`},
+		{"invalid record", header + `{"type":"user","record":{"nickname":"jo"}}`, "line 2: invalid user"},
+		{"repeated", header + `{"type":"user","record":{"id":"jo","email":"a"}}` + "\n" + `{"type":"user","record":{"id":"jo","email":"b"}}`, "line 3: user jo is repeated"},
+		{"missing member", header + `{"type":"club","record":{"id":"club","memberIds":["jo"]}}`, "line 2: club club: member jo isn't in the archive before it"},
+		{"missing club", header + `{"type":"user","record":{"id":"jo"}}` + "\n" + `{"type":"pick","record":{"id":"heat","clubId":"club","userId":"jo"}}`, "line 3: pick heat: club club isn't in the archive"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := storage.NewMemoryStorage()
+			_, err := Import(ctx, strings.NewReader(test.archive), s, false)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package backup takes rotating snapshots of SQLite storage, and restores them
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// Snapshots are named after the time they were taken, to the millisecond, so that they sort oldest first
+const (
+	snapshotPrefix     = "watchclub-"
+	snapshotSuffix     = ".db"
+	snapshotTimeFormat = "20060102T150405.000Z"
+)
+
+// Snapshot writes a snapshot of the storage to a new file in dir, then deletes all but the newest keep snapshots.
+// keep of zero or less keeps every snapshot. Returns the path of the new snapshot.
+func Snapshot(ctx context.Context, b storage.Backuper, dir string, keep int, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	// snapshots are written under a temporary name, so that a partial one is never mistaken for a complete one
+	path := filepath.Join(dir, snapshotPrefix+now.UTC().Format(snapshotTimeFormat)+snapshotSuffix)
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("snapshot already exists: %s", path)
+	}
+	tmp := filepath.Join(dir, "."+filepath.Base(path)+".tmp")
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed to remove incomplete snapshot: %w", err)
+	}
+	if err := b.Backup(ctx, tmp); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	if err := rotate(dir, keep); err != nil {
+		return path, err
+	}
+	return path, nil
+}
+
+// List returns the paths of the snapshots in dir, oldest first
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+	var snapshots []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, snapshotPrefix) && strings.HasSuffix(name, snapshotSuffix) {
+			snapshots = append(snapshots, filepath.Join(dir, name))
+		}
+	}
+	slices.Sort(snapshots)
+	return snapshots, nil
+}
+
+// rotate deletes all but the newest keep snapshots in dir
+func rotate(dir string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+	snapshots, err := List(dir)
+	if err != nil {
+		return err
+	}
+	for len(snapshots) > keep {
+		if err := os.Remove(snapshots[0]); err != nil {
+			return fmt.Errorf("failed to delete old snapshot: %w", err)
+		}
+		snapshots = snapshots[1:]
+	}
+	return nil
+}
+
+// Run takes a snapshot every interval until ctx is done
+func Run(ctx context.Context, logger *zap.Logger, b storage.Backuper, dir string, keep int, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		path, err := Snapshot(ctx, b, dir, keep, time.Now())
+		if err != nil {
+			logger.Error("Failed to take snapshot", zap.String("dir", dir), zap.Error(err))
+			continue
+		}
+		logger.Info("Took snapshot", zap.String("path", path))
+	}
+}
+
+// Restore replaces the SQLite database at dbPath with a snapshot, after checking that the snapshot is intact.
+// Nothing may be using the database while it's restored, so the server must be stopped first.
+func Restore(ctx context.Context, snapshot string, dbPath string) error {
+	if err := storage.CheckSQLiteFile(ctx, snapshot); err != nil {
+		return fmt.Errorf("failed to check snapshot: %w", err)
+	}
+
+	// copy next to the database first, so that the database is replaced all at once
+	tmp := dbPath + ".restore"
+	if err := copyFile(snapshot, tmp); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to copy snapshot: %w", err)
+	}
+
+	// the old database's write-ahead log would otherwise be applied to the restored one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			os.Remove(tmp)
+			return fmt.Errorf("failed to remove %s file: %w", suffix, err)
+		}
+	}
+	if err := os.Rename(tmp, dbPath); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to replace database: %w", err)
+	}
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
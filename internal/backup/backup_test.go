@@ -0,0 +1,84 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+func Test_SnapshotAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "watchclub.db")
+	backups := filepath.Join(dir, "backups")
+
+	store, err := storage.NewSQLiteStorage(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	first, err := Snapshot(ctx, store.(storage.Backuper), backups, 2, now)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(backups, "watchclub-20260301T120000.000Z.db"), first)
+
+	// changes after a snapshot aren't in it
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	for i := 1; i <= 2; i++ {
+		_, err := Snapshot(ctx, store.(storage.Backuper), backups, 2, now.Add(time.Duration(i)*time.Hour))
+		require.NoError(t, err)
+	}
+
+	// only the newest snapshots are kept
+	snapshots, err := List(backups)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(backups, "watchclub-20260301T130000.000Z.db"),
+		filepath.Join(backups, "watchclub-20260301T140000.000Z.db"),
+	}, snapshots)
+
+	// snapshots taken within the same second don't replace each other
+	second, err := Snapshot(ctx, store.(storage.Backuper), backups, 0, now.Add(2*time.Hour+250*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(backups, "watchclub-20260301T140000.250Z.db"), second)
+	_, err = Snapshot(ctx, store.(storage.Backuper), backups, 0, now.Add(2*time.Hour+250*time.Millisecond))
+	assert.ErrorContains(t, err, "already exists")
+	snapshots, err = List(backups)
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 3)
+
+	restored := filepath.Join(dir, "restored.db")
+	require.NoError(t, os.WriteFile(restored+"-wal", []byte("stale"), 0o600))
+	require.NoError(t, Restore(ctx, snapshots[0], restored))
+	_, err = os.Stat(restored + "-wal")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	store, err = storage.NewSQLiteStorage(restored)
+	require.NoError(t, err)
+	users, err := store.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+}
+
+func Test_RestoreChecksSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "watchclub.db")
+	require.NoError(t, os.WriteFile(dbPath, []byte("current"), 0o600))
+
+	garbage := filepath.Join(dir, "garbage.db")
+	require.NoError(t, os.WriteFile(garbage, []byte("not a database"), 0o600))
+	assert.Error(t, Restore(ctx, garbage, dbPath))
+
+	// the database is left alone
+	data, err := os.ReadFile(dbPath)
+	require.NoError(t, err)
+	assert.Equal(t, "current", string(data))
+}
@@ -0,0 +1,363 @@
+package ics
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest a content line can be before it's folded (RFC 5545 section 3.1)
+const maxLineOctets = 75
+
+// Encode writes the calendar to w
+func (c *Calendar) Encode(w io.Writer) error {
+	b, err := c.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// Marshal serializes the calendar
+func (c *Calendar) Marshal() ([]byte, error) {
+	if c.ProdID == "" {
+		return nil, errors.New("calendar is missing PRODID")
+	}
+
+	e := &encoder{}
+	e.begin("VCALENDAR")
+	e.property("VERSION", "2.0")
+	e.property("PRODID", escapeText(c.ProdID))
+	e.property("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		e.property("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		e.property("X-WR-CALNAME", escapeText(c.Name))
+	}
+	if c.DisplayTimezone != "" {
+		e.property("X-WR-TIMEZONE", escapeText(c.DisplayTimezone))
+	}
+	if c.RefreshInterval > 0 {
+		e.property("REFRESH-INTERVAL", formatDuration(c.RefreshInterval), param{"VALUE", "DURATION"})
+		e.property("X-PUBLISHED-TTL", formatDuration(c.RefreshInterval))
+	}
+
+	for _, tz := range c.Timezones {
+		if err := e.timezone(tz); err != nil {
+			return nil, err
+		}
+	}
+	for _, event := range c.Events {
+		if err := e.event(event); err != nil {
+			return nil, err
+		}
+	}
+
+	e.end("VCALENDAR")
+	return e.buf.Bytes(), nil
+}
+
+// String serializes the calendar, or returns an empty string if it's invalid
+func (c *Calendar) String() string {
+	b, err := c.Marshal()
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+type param struct {
+	name  string
+	value string
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) begin(component string) {
+	e.property("BEGIN", component)
+}
+
+func (e *encoder) end(component string) {
+	e.property("END", component)
+}
+
+// property writes a content line. The value must already be escaped for its type.
+func (e *encoder) property(name string, value string, params ...param) {
+	var line strings.Builder
+	line.WriteString(name)
+	for _, p := range params {
+		line.WriteByte(';')
+		line.WriteString(p.name)
+		line.WriteByte('=')
+		line.WriteString(escapeParam(p.value))
+	}
+	line.WriteByte(':')
+	line.WriteString(value)
+	e.fold(line.String())
+}
+
+// fold writes a content line, splitting it into lines of at most 75 octets without breaking UTF-8 sequences
+func (e *encoder) fold(line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		e.buf.WriteString(line[:i])
+		e.buf.WriteString("\r\n ")
+		line = line[i:]
+		// continuation lines start with a space
+		limit = maxLineOctets - 1
+	}
+	e.buf.WriteString(line)
+	e.buf.WriteString("\r\n")
+}
+
+func (e *encoder) timezone(tz *Timezone) error {
+	if tz.ID == "" {
+		return errors.New("timezone is missing TZID")
+	}
+	if len(tz.Observances) == 0 {
+		return fmt.Errorf("timezone %s has no observances", tz.ID)
+	}
+
+	e.begin("VTIMEZONE")
+	e.property("TZID", escapeText(tz.ID))
+	for _, o := range tz.Observances {
+		component := "STANDARD"
+		if o.Daylight {
+			component = "DAYLIGHT"
+		}
+		e.begin(component)
+		e.property("DTSTART", formatLocalDateTime(o.Start))
+		e.property("TZOFFSETFROM", formatOffset(o.OffsetFrom))
+		e.property("TZOFFSETTO", formatOffset(o.OffsetTo))
+		if o.Name != "" {
+			e.property("TZNAME", escapeText(o.Name))
+		}
+		if o.RRule != "" {
+			e.property("RRULE", o.RRule)
+		}
+		e.end(component)
+	}
+	e.end("VTIMEZONE")
+	return nil
+}
+
+func (e *encoder) event(ev *Event) error {
+	if ev.UID == "" {
+		return errors.New("event is missing UID")
+	}
+	if ev.Stamp.IsZero() {
+		return fmt.Errorf("event %s is missing DTSTAMP", ev.UID)
+	}
+	if ev.Start.IsZero() {
+		return fmt.Errorf("event %s is missing DTSTART", ev.UID)
+	}
+
+	e.begin("VEVENT")
+	e.property("UID", escapeText(ev.UID))
+	if ev.Sequence > 0 {
+		e.property("SEQUENCE", strconv.Itoa(ev.Sequence))
+	}
+	e.property("DTSTAMP", formatUTCDateTime(ev.Stamp))
+	if ev.Organizer != nil {
+		e.property("ORGANIZER", escapeURI("mailto:"+ev.Organizer.Email), nameParams(ev.Organizer.Name)...)
+	}
+	for _, a := range ev.Attendees {
+		params := append(nameParams(a.Name), param{"RSVP", strings.ToUpper(strconv.FormatBool(a.RSVP))})
+		e.property("ATTENDEE", escapeURI("mailto:"+a.Email), params...)
+	}
+	if ev.Status != "" {
+		e.property("STATUS", ev.Status)
+	}
+	e.time("DTSTART", ev.Start, ev)
+	if !ev.End.IsZero() {
+		e.time("DTEND", ev.End, ev)
+	}
+	if ev.Summary != "" {
+		e.property("SUMMARY", escapeText(ev.Summary))
+	}
+	if ev.Description != "" {
+		e.property("DESCRIPTION", escapeText(ev.Description))
+	}
+	if ev.Location != "" {
+		e.property("LOCATION", escapeText(ev.Location))
+	}
+	if ev.URL != "" {
+		e.property("URL", escapeURI(ev.URL))
+	}
+	if ev.Transparent {
+		e.property("TRANSP", "TRANSPARENT")
+	}
+
+	for _, alarm := range ev.Alarms {
+		description := alarm.Description
+		if description == "" {
+			description = ev.Summary
+		}
+		e.begin("VALARM")
+		e.property("ACTION", "DISPLAY")
+		e.property("TRIGGER", formatDuration(-alarm.Before))
+		e.property("DESCRIPTION", escapeText(description))
+		e.end("VALARM")
+	}
+
+	e.end("VEVENT")
+	return nil
+}
+
+// time writes a DATE or DATE-TIME property of an event
+func (e *encoder) time(name string, t time.Time, ev *Event) {
+	switch {
+	case ev.AllDay:
+		e.property(name, t.Format("20060102"), param{"VALUE", "DATE"})
+	case ev.TZID != "":
+		e.property(name, formatLocalDateTime(t), param{"TZID", ev.TZID})
+	default:
+		e.property(name, formatUTCDateTime(t))
+	}
+}
+
+func nameParams(name string) []param {
+	if name == "" {
+		return nil
+	}
+	return []param{{"CN", name}}
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeText(s string) string {
+	s = strings.ToValidUTF8(s, "\uFFFD")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '\\', ';', ',':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n', '\r':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteRune(r)
+		default:
+			// other control characters aren't allowed
+			if r < 0x20 || r == 0x7f {
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// escapeURI drops the characters a URI or CAL-ADDRESS value can't contain (RFC 5545 sections 3.3.3 and 3.3.13),
+// which have no escapes, so a value can't end its content line and start another
+func escapeURI(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// escapeParam encodes a parameter value (RFC 5545 section 3.2 and RFC 6868), quoting it if needed
+func escapeParam(s string) string {
+	s = strings.ToValidUTF8(s, "\uFFFD")
+
+	var b strings.Builder
+	quote := false
+	for _, r := range s {
+		switch r {
+		case '^':
+			b.WriteString("^^")
+		case '\n':
+			b.WriteString("^n")
+		case '"':
+			b.WriteString("^'")
+		case ';', ':', ',':
+			quote = true
+			b.WriteRune(r)
+		default:
+			if (r < 0x20 && r != '\t') || r == 0x7f {
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	if quote {
+		return `"` + b.String() + `"`
+	}
+	return b.String()
+}
+
+func formatUTCDateTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func formatLocalDateTime(t time.Time) string {
+	return t.Format("20060102T150405")
+}
+
+// formatOffset formats a UTC offset, e.g. -0500
+func formatOffset(d time.Duration) string {
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	seconds := int(d / time.Second)
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+// formatDuration formats a DURATION value (RFC 5545 section 3.3.6), e.g. -PT15M
+func formatDuration(d time.Duration) string {
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	b.WriteByte('P')
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 || days == 0 {
+		b.WriteByte('T')
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds > 0 || (hours == 0 && minutes == 0) {
+			fmt.Fprintf(&b, "%dS", seconds)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,113 @@
+// Package ics models iCalendar (RFC 5545) calendars and serializes them.
+//
+// It covers what WatchClub publishes: events with alarms, organizers and attendees,
+// timezone definitions, and the iTIP (RFC 5546) methods used to update other people's calendars.
+package ics
+
+import "time"
+
+// iTIP methods (RFC 5546)
+const (
+	// MethodPublish is a snapshot of a calendar, e.g. for importing or subscribing
+	MethodPublish = "PUBLISH"
+	// MethodRequest creates or updates events in an attendee's calendar
+	MethodRequest = "REQUEST"
+	// MethodCancel removes events from an attendee's calendar
+	MethodCancel = "CANCEL"
+)
+
+// Event statuses
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is a VCALENDAR object
+type Calendar struct {
+	// ProdID identifies the product that created the calendar, e.g. "-//WatchClub//Schedule//EN"
+	ProdID string
+	// Method is the iTIP method, if any
+	Method string
+	// Name is shown by calendar apps that subscribe to the calendar (X-WR-CALNAME)
+	Name string
+	// DisplayTimezone is the timezone calendar apps show the calendar in (X-WR-TIMEZONE)
+	DisplayTimezone string
+	// RefreshInterval is how often subscribers should refresh the calendar, if set
+	RefreshInterval time.Duration
+
+	Timezones []*Timezone
+	Events    []*Event
+}
+
+// Event is a VEVENT component
+type Event struct {
+	// UID identifies the event across versions, and is required
+	UID string
+	// Sequence is incremented whenever the organizer changes the event
+	Sequence int
+	// Stamp is when this version of the event was created, and is required
+	Stamp time.Time
+
+	// Start is required. End is optional.
+	Start time.Time
+	End   time.Time
+	// AllDay events only use the dates of Start and End
+	AllDay bool
+	// TZID refers to a Timezone in the calendar. When set, Start and End are written as
+	// the wall clock time in their location; otherwise they're written in UTC.
+	TZID string
+
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Status      string
+	// Transparent events don't block time in the attendee's calendar
+	Transparent bool
+
+	Organizer *Organizer
+	Attendees []*Attendee
+	Alarms    []*Alarm
+}
+
+// Organizer is the ORGANIZER of an event
+type Organizer struct {
+	Name  string
+	Email string
+}
+
+// Attendee is an ATTENDEE of an event
+type Attendee struct {
+	Name  string
+	Email string
+	// RSVP asks the attendee to reply
+	RSVP bool
+}
+
+// Alarm is a VALARM component that displays a reminder
+type Alarm struct {
+	// Before is how long before the event starts the alarm goes off
+	Before time.Duration
+	// Description is shown by the alarm; the event's summary is used if it's empty
+	Description string
+}
+
+// Timezone is a VTIMEZONE component
+type Timezone struct {
+	ID          string
+	Observances []*Observance
+}
+
+// Observance is a STANDARD or DAYLIGHT rule of a timezone
+type Observance struct {
+	Daylight bool
+	// Start is the wall clock time the observance begins
+	Start      time.Time
+	OffsetFrom time.Duration
+	OffsetTo   time.Duration
+	// Name is the abbreviation, e.g. "EST"
+	Name string
+	// RRule is the recurrence rule, e.g. "FREQ=YEARLY;BYMONTH=11;BYDAY=1SU"
+	RRule string
+}
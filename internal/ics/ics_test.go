@@ -0,0 +1,216 @@
+package ics
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var (
+	stamp = time.Date(2026, time.March, 1, 12, 30, 0, 0, time.UTC)
+	start = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func Test_Marshal_Golden(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := map[string]*Calendar{
+		"publish": {
+			ProdID:          "-//WatchClub//Schedule//EN",
+			Method:          MethodPublish,
+			Name:            "Movie Night; Fridays, mostly",
+			DisplayTimezone: "UTC",
+			RefreshInterval: time.Hour,
+			Events: []*Event{
+				{
+					UID:         "a@watchclub",
+					Stamp:       stamp,
+					Start:       start,
+					End:         start.Add(7 * 24 * time.Hour),
+					AllDay:      true,
+					Summary:     "Amélie (2001)",
+					Description: "Picked by Zoë\n\nNotes: a very long note about why this one, which goes on and on so that the line has to be folded — more than once, in fact, with some multi-byte characters like 日本語 along the way\n\nView details: https://watchclub.example.com/#/club/1/pick/a",
+					Location:    "https://example.com/watch?v=a,b;c",
+					URL:         "https://example.com/watch?v=a,b;c",
+					Transparent: true,
+				},
+				{
+					UID:     "b@watchclub",
+					Stamp:   stamp,
+					Start:   start.Add(7 * 24 * time.Hour),
+					AllDay:  true,
+					Summary: `Back\slash`,
+				},
+			},
+		},
+		"request": {
+			ProdID: "-//WatchClub//Schedule//EN",
+			Method: MethodRequest,
+			Timezones: []*Timezone{
+				{
+					ID: "America/New_York",
+					Observances: []*Observance{
+						{
+							Daylight:   true,
+							Start:      time.Date(2007, time.March, 11, 2, 0, 0, 0, time.UTC),
+							OffsetFrom: -5 * time.Hour,
+							OffsetTo:   -4 * time.Hour,
+							Name:       "EDT",
+							RRule:      "FREQ=YEARLY;BYMONTH=3;BYDAY=2SU",
+						},
+						{
+							Start:      time.Date(2007, time.November, 4, 2, 0, 0, 0, time.UTC),
+							OffsetFrom: -4 * time.Hour,
+							OffsetTo:   -5 * time.Hour,
+							Name:       "EST",
+							RRule:      "FREQ=YEARLY;BYMONTH=11;BYDAY=1SU",
+						},
+					},
+				},
+			},
+			Events: []*Event{
+				{
+					UID:       "a@watchclub",
+					Sequence:  2,
+					Stamp:     stamp,
+					Start:     time.Date(2026, time.March, 6, 20, 0, 0, 0, newYork),
+					End:       time.Date(2026, time.March, 6, 22, 0, 0, 0, newYork),
+					TZID:      "America/New_York",
+					Summary:   "Heat (1995)",
+					Status:    StatusConfirmed,
+					Organizer: &Organizer{Name: "Movie Night: \"The Club\"", Email: "club@watchclub.example.com"},
+					Attendees: []*Attendee{{Name: "Jo", Email: "jo@example.com"}},
+					Alarms:    []*Alarm{{Before: 15 * time.Minute}, {Before: 24 * time.Hour, Description: "Tomorrow: Heat"}},
+				},
+			},
+		},
+		"cancel": {
+			ProdID: "-//WatchClub//Schedule//EN",
+			Method: MethodCancel,
+			Events: []*Event{
+				{
+					UID:       "a@watchclub",
+					Sequence:  3,
+					Stamp:     stamp,
+					Start:     start,
+					End:       start.Add(24 * time.Hour),
+					AllDay:    true,
+					Summary:   "Heat (1995)",
+					Status:    StatusCancelled,
+					Organizer: &Organizer{Name: "Movie Night", Email: "club@watchclub.example.com"},
+					Attendees: []*Attendee{{Name: "Jo", Email: "jo@example.com", RSVP: true}},
+				},
+			},
+		},
+	}
+
+	for name, calendar := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := calendar.Marshal()
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", name+".ics")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, got, 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got))
+
+			for _, line := range strings.SplitAfter(string(got), "\r\n") {
+				assert.LessOrEqual(t, len(strings.TrimSuffix(line, "\r\n")), 75, line)
+			}
+		})
+	}
+}
+
+func Test_Marshal_Invalid(t *testing.T) {
+	_, err := (&Calendar{}).Marshal()
+	assert.Error(t, err)
+
+	_, err = (&Calendar{ProdID: "x", Events: []*Event{{Stamp: stamp, Start: start}}}).Marshal()
+	assert.ErrorContains(t, err, "UID")
+
+	_, err = (&Calendar{ProdID: "x", Events: []*Event{{UID: "a", Start: start}}}).Marshal()
+	assert.ErrorContains(t, err, "DTSTAMP")
+}
+
+func Test_Fold(t *testing.T) {
+	e := &encoder{}
+	// 74 octets of ASCII followed by a 3 octet character, which can't be split
+	e.fold(strings.Repeat("a", 74) + "日" + strings.Repeat("b", 80))
+	lines := strings.Split(strings.TrimSuffix(e.buf.String(), "\r\n"), "\r\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, strings.Repeat("a", 74), lines[0])
+	assert.Equal(t, " 日"+strings.Repeat("b", 71), lines[1])
+	assert.Equal(t, " "+strings.Repeat("b", 9), lines[2])
+}
+
+func Test_EscapeText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, escapeText("a\\b;c,d\r\ne\nf"))
+	assert.Equal(t, "bell", escapeText("be\all"))
+	assert.Equal(t, "x�y", escapeText("x\xffy"))
+}
+
+func Test_EscapeURI(t *testing.T) {
+	assert.Equal(t, "https://example.com/a?b=c;d", escapeURI("https://example.com/a?b=c;d"))
+	assert.Equal(t, "https://aBEGIN:VALARM", escapeURI("https://a\r\nBEGIN:VALARM"))
+	assert.Equal(t, "mailto:ab", escapeURI("mailto:a\tb\xff"))
+}
+
+func Test_Marshal_Injection(t *testing.T) {
+	b, err := (&Calendar{ProdID: "x", Events: []*Event{{
+		UID:       "a",
+		Stamp:     stamp,
+		Start:     start,
+		Organizer: &Organizer{Email: "a@b\r\nATTENDEE:evil"},
+		Attendees: []*Attendee{{Email: "c@d\nSTATUS:CANCELLED"}},
+		URL:       "https://a\r\nBEGIN:VALARM",
+	}}}).Marshal()
+	require.NoError(t, err)
+	lines := strings.Split(string(b), "\r\n")
+	assert.Contains(t, lines, "ORGANIZER:mailto:a@bATTENDEE:evil")
+	assert.Contains(t, lines, "ATTENDEE;RSVP=FALSE:mailto:c@dSTATUS:CANCELLED")
+	assert.Contains(t, lines, "URL:https://aBEGIN:VALARM")
+	for _, line := range lines {
+		assert.NotContains(t, line, "\n")
+		assert.NotContains(t, line, "\r")
+		assert.False(t, strings.HasPrefix(line, "BEGIN:VALARM"), line)
+		assert.False(t, strings.HasPrefix(line, "ATTENDEE:"), line)
+		assert.False(t, strings.HasPrefix(line, "STATUS:"), line)
+	}
+}
+
+func Test_EscapeParam(t *testing.T) {
+	assert.Equal(t, "Jo Smith", escapeParam("Jo Smith"))
+	assert.Equal(t, `"Smith, Jo"`, escapeParam("Smith, Jo"))
+	assert.Equal(t, "^'Jo^' ^^^n", escapeParam("\"Jo\" ^\n"))
+}
+
+func Test_FormatDuration(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                             "PT0S",
+		time.Hour:                     "PT1H",
+		-15 * time.Minute:             "-PT15M",
+		24 * time.Hour:                "P1D",
+		25*time.Hour + 30*time.Second: "P1DT1H30S",
+		-90 * time.Second:             "-PT1M30S",
+	} {
+		assert.Equal(t, want, formatDuration(d), d.String())
+	}
+}
+
+func Test_FormatOffset(t *testing.T) {
+	assert.Equal(t, "-0500", formatOffset(-5*time.Hour))
+	assert.Equal(t, "+0530", formatOffset(5*time.Hour+30*time.Minute))
+	assert.Equal(t, "+0000", formatOffset(0))
+}
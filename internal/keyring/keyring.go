@@ -0,0 +1,170 @@
+// Package keyring encrypts fields of records with AES-256-GCM, under keys with IDs so that they can be rotated,
+// and computes blind indexes (keyed hashes) so that encrypted fields can still be looked up by value.
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvVar is the environment variable keys are read from when there's no key file
+const EnvVar = "WATCHCLUB_ENCRYPTION_KEYS"
+
+// indexKeyID is the ID of the key that blind indexes are computed with
+const indexKeyID = "index"
+
+// prefix starts every encrypted value, followed by the key ID and the base64 nonce and ciphertext
+const prefix = "enc:v1:"
+
+// keySize is the size of every key, which makes the encryption keys AES-256
+const keySize = 32
+
+// Keyring holds the keys that fields are encrypted with.
+// The current key encrypts, and the others are only used to decrypt values encrypted before it was added.
+type Keyring struct {
+	current string
+	keys    map[string]cipher.AEAD
+	index   []byte
+}
+
+// Parse reads keys written as id=base64, separated by newlines or commas. Lines starting with # are ignored.
+// Each key is 32 random bytes, e.g. from `openssl rand -base64 32`. The key with ID "index" computes blind indexes,
+// and is never rotated, since that would change every index; the first of the rest is the current key.
+func Parse(text string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]cipher.AEAD)}
+	entries := strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == ',' })
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, "=")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" || strings.ContainsAny(id, ": ") {
+			return nil, fmt.Errorf("invalid key %q: expected id=base64, with no colons or spaces in the id", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("invalid key %q: expected %d bytes of base64", id, keySize)
+		}
+
+		if id == indexKeyID {
+			if k.index != nil {
+				return nil, errors.New("duplicate index key")
+			}
+			k.index = key
+			continue
+		}
+		if _, ok := k.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate key %q", id)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = aead
+		if k.current == "" {
+			k.current = id
+		}
+	}
+
+	if k.index == nil {
+		return nil, errors.New("missing index key")
+	}
+	if k.current == "" {
+		return nil, errors.New("missing encryption key")
+	}
+	return k, nil
+}
+
+// Load reads keys from a file, or from the WATCHCLUB_ENCRYPTION_KEYS environment variable if path is empty.
+// It returns nil if neither is set, which means fields aren't encrypted.
+func Load(path string) (*Keyring, error) {
+	text, ok := os.LookupEnv(EnvVar)
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keys: %w", err)
+		}
+		text, ok = string(data), true
+	}
+	if !ok {
+		return nil, nil
+	}
+	k, err := Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse keys: %w", err)
+	}
+	return k, nil
+}
+
+// Current returns the ID of the key that encrypts
+func (k *Keyring) Current() string {
+	return k.current
+}
+
+// Encrypt encrypts a value with the current key. The context, e.g. the table, column, and row, is authenticated
+// along with it, so that an encrypted value can't be copied to another field or record.
+func (k *Keyring) Encrypt(plaintext string, context string) (string, error) {
+	aead := k.keys[k.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(context))
+	return prefix + k.current + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted with any of the keys, with the context it was encrypted with
+func (k *Keyring) Decrypt(ciphertext string, context string) (string, error) {
+	id, ok := KeyID(ciphertext)
+	if !ok {
+		return "", errors.New("value isn't encrypted")
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return "", fmt.Errorf("value is encrypted with unknown key %q", id)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(ciphertext, prefix+id+":"))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted value is malformed")
+	}
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, []byte(context))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value with key %q: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// Index returns the blind index of a value, which is the same for equal values in the same context,
+// but can't be reversed without the index key
+func (k *Keyring) Index(value string, context string) string {
+	mac := hmac.New(sha256.New, k.index)
+	mac.Write([]byte(context))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return base64.RawStdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// KeyID returns the ID of the key a value was encrypted with, or false if it isn't encrypted
+func KeyID(value string) (string, bool) {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return "", false
+	}
+	id, _, ok := strings.Cut(rest, ":")
+	return id, ok
+}
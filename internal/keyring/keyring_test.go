@@ -0,0 +1,103 @@
+package keyring
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) string {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func Test_EncryptAndRotate(t *testing.T) {
+	index, oldKey, newKeyValue := newKey(t), newKey(t), newKey(t)
+	old, err := Parse("index=" + index + "\nold=" + oldKey)
+	require.NoError(t, err)
+
+	ciphertext, err := old.Encrypt("jo@example.com", "users.email:jo")
+	require.NoError(t, err)
+	assert.NotContains(t, ciphertext, "jo@example.com")
+	id, ok := KeyID(ciphertext)
+	require.True(t, ok)
+	assert.Equal(t, "old", id)
+	_, ok = KeyID("jo@example.com")
+	assert.False(t, ok)
+
+	// encryption is randomized, but indexes aren't
+	again, err := old.Encrypt("jo@example.com", "users.email:jo")
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, again)
+	assert.Equal(t, old.Index("jo@example.com", "users.email"), old.Index("jo@example.com", "users.email"))
+	assert.NotEqual(t, old.Index("jo@example.com", "users.email"), old.Index("sam@example.com", "users.email"))
+
+	// the context is authenticated
+	_, err = old.Decrypt(ciphertext, "users.email:sam")
+	assert.ErrorContains(t, err, "failed to decrypt")
+	plaintext, err := old.Decrypt(ciphertext, "users.email:jo")
+	require.NoError(t, err)
+	assert.Equal(t, "jo@example.com", plaintext)
+
+	// after rotating, the new key encrypts and the old one still decrypts, and indexes don't change
+	rotated, err := Parse("# keys\nindex=" + index + ",new=" + newKeyValue + ",old=" + oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, "new", rotated.Current())
+	plaintext, err = rotated.Decrypt(ciphertext, "users.email:jo")
+	require.NoError(t, err)
+	assert.Equal(t, "jo@example.com", plaintext)
+	assert.Equal(t, old.Index("jo@example.com", "users.email"), rotated.Index("jo@example.com", "users.email"))
+
+	// once the old key is removed, what it encrypted can't be read
+	removed, err := Parse("index=" + index + ",new=" + newKeyValue)
+	require.NoError(t, err)
+	_, err = removed.Decrypt(ciphertext, "users.email:jo")
+	assert.ErrorContains(t, err, `unknown key "old"`)
+}
+
+func Test_ParseErrors(t *testing.T) {
+	index, key := newKey(t), newKey(t)
+	for text, expected := range map[string]string{
+		"":                                "missing index key",
+		"index=" + index:                  "missing encryption key",
+		"k1=" + key:                       "missing index key",
+		"index=" + index + ",k1":          "expected id=base64",
+		"index=" + index + ",k:1=" + key:  "no colons",
+		"index=" + index + ",k1=c2hvcnQ=": "expected 32 bytes",
+		"index=" + index + ",k1=" + key + ",k1=" + key: `duplicate key "k1"`,
+		"index=" + index + ",index=" + key:             "duplicate index key",
+	} {
+		_, err := Parse(text)
+		assert.ErrorContains(t, err, expected, text)
+	}
+}
+
+func Test_Load(t *testing.T) {
+	t.Setenv(EnvVar, "")
+	os.Unsetenv(EnvVar)
+	k, err := Load("")
+	require.NoError(t, err)
+	assert.Nil(t, k)
+
+	t.Setenv(EnvVar, "index="+newKey(t)+",env="+newKey(t))
+	k, err = Load("")
+	require.NoError(t, err)
+	assert.Equal(t, "env", k.Current())
+
+	// a file takes precedence over the environment
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte("index="+newKey(t)+"\nfile="+newKey(t)+"\n"), 0o600))
+	k, err = Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "file", k.Current())
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, err, "failed to read keys")
+}
@@ -1,143 +1,56 @@
 package mail
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 )
 
-// Config holds email configuration
-type Config struct {
-	// DevelopmentMode logs emails to console instead of sending
-	DevelopmentMode bool
-
-	// BaseURL is the base URL for generating login links
-	BaseURL string
-
-	// Resend configuration
-	ResendAPIKey   string
-	ResendFrom     string // Email address to send from (e.g., "you@yourdomain.com")
-	ResendFromName string // Optional display name (e.g., "WatchClub")
-
-	Logger *zap.Logger
-}
-
-// New creates a new email sender
-func New(config Config) Sender {
-	// Use development mode if explicitly enabled
-	if config.DevelopmentMode {
-		return &devSender{
-			baseURL: config.BaseURL,
-			logger:  config.Logger,
-		}
-	}
-
-	// Use Resend if API key is provided
-	if config.ResendAPIKey != "" {
-		sender, err := newResendSender(
-			config.ResendAPIKey,
-			config.ResendFrom,
-			config.ResendFromName,
-			config.BaseURL,
-			config.Logger,
-		)
-		if err != nil {
-			config.Logger.Error("Failed to create Resend sender, falling back to dev mode",
-				zap.Error(err),
-			)
-			return &devSender{
-				baseURL: config.BaseURL,
-				logger:  config.Logger,
-			}
-		}
-		return sender
-	}
-
-	// Default to development mode if no email provider is configured
-	config.Logger.Warn("No email provider configured, using development mode (console logging)")
-	return &devSender{
-		baseURL: config.BaseURL,
-		logger:  config.Logger,
-	}
-}
-
 // devSender logs emails to console instead of sending them
 type devSender struct {
-	baseURL string
-	logger  *zap.Logger
+	logger *zap.Logger
+
+	// inbox captures messages for viewing over HTTP (optional)
+	inbox *Inbox
 }
 
-func (d *devSender) SendLogin(to, userName, userID, baseURL string) error {
-	if baseURL == "" {
-		baseURL = d.baseURL
+func (d *devSender) Send(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	recoveryLink := fmt.Sprintf("%s#/login/%s", baseURL, userID)
+	var attachments strings.Builder
+	for _, a := range msg.Attachments {
+		fmt.Fprintf(&attachments, "\nAttachment: %s (%s, %d bytes)", a.Filename, a.ContentType, len(a.Content))
+	}
 
 	emailBody := fmt.Sprintf(`
 ========================================
-WATCHCLUB ACCOUNT RECOVERY
+To: %s
+Subject: %s
 ========================================
-
-Hi %s,
-
-Click the link below to log back into your account:
-
 %s
-
-This link will automatically log you in.
-
 ========================================
-`, userName, recoveryLink)
+%s
+`, strings.Join(msg.To, ", "), msg.Subject, msg.Text, attachments.String())
 
-	d.logger.Info("📧 RECOVERY EMAIL (Development Mode)",
-		zap.String("to", to),
-		zap.String("userName", userName),
-		zap.String("recoveryLink", recoveryLink),
+	d.logger.Info("📧 EMAIL (Development Mode)",
+		zap.Strings("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.Any("tags", msg.Tags),
+		zap.Int("attachments", len(msg.Attachments)),
 	)
 
 	fmt.Println(emailBody)
 
-	return nil
-}
-
-func (d *devSender) SendClubStarted(to, userName, clubName, clubID, baseURL string, icsData []byte) error {
-	if baseURL == "" {
-		baseURL = d.baseURL
+	if d.inbox != nil {
+		m := d.inbox.Add(msg)
+		d.logger.Info("📬 Email captured in development inbox",
+			zap.String("url", "/dev/mail?id="+m.ID),
+		)
 	}
 
-	clubLink := fmt.Sprintf("%s#/club/%s", baseURL, clubID)
-
-	emailBody := fmt.Sprintf(`
-========================================
-WATCHCLUB - CLUB STARTED!
-========================================
-
-Hi %s,
-
-Great news! The club "%s" has started!
-
-View the schedule and picks:
-%s
-
-The full schedule is attached as a calendar file (ICS).
-You can import it into Google Calendar, Apple Calendar,
-Outlook, or any other calendar app.
-
-========================================
-
-Calendar ICS Data: %d bytes
-`, userName, clubName, clubLink, len(icsData))
-
-	d.logger.Info("📧 CLUB STARTED EMAIL (Development Mode)",
-		zap.String("to", to),
-		zap.String("userName", userName),
-		zap.String("clubName", clubName),
-		zap.String("clubLink", clubLink),
-		zap.Int("icsDataSize", len(icsData)),
-	)
-
-	fmt.Println(emailBody)
-
 	return nil
 }
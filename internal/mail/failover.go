@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cartermckinnon/watchclub/internal/util"
+)
+
+const (
+	// unhealthyThreshold is the number of consecutive failures after which a provider is skipped
+	unhealthyThreshold = 3
+	// minCooldown is how long an unhealthy provider is skipped for; it doubles with each further failure
+	minCooldown = 30 * time.Second
+	// maxCooldown caps the time an unhealthy provider is skipped for
+	maxCooldown = 10 * time.Minute
+)
+
+// provider is a Sender with its own rate limit and health state
+type provider struct {
+	name    string
+	sender  Sender
+	limiter *util.TokenBucket // nil means unlimited
+
+	mu             sync.Mutex
+	failures       int
+	unhealthyUntil time.Time
+}
+
+func newProvider(name string, sender Sender, rateLimit float64) *provider {
+	p := &provider{
+		name:   name,
+		sender: sender,
+	}
+	if rateLimit > 0 {
+		p.limiter = util.NewTokenBucket(rateLimit, 1)
+	}
+	return p
+}
+
+func (p *provider) healthy(now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !now.Before(p.unhealthyUntil)
+}
+
+func (p *provider) recordSuccess() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures = 0
+	p.unhealthyUntil = time.Time{}
+}
+
+// recordFailure returns the cooldown if the provider is now unhealthy, or zero
+func (p *provider) recordFailure(now time.Time) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures++
+	if p.failures < unhealthyThreshold {
+		return 0
+	}
+	cooldown := minCooldown
+	for i := unhealthyThreshold; i < p.failures && cooldown < maxCooldown; i++ {
+		cooldown *= 2
+	}
+	cooldown = min(cooldown, maxCooldown)
+	p.unhealthyUntil = now.Add(cooldown)
+	return cooldown
+}
+
+// failoverSender tries each provider in priority order until one succeeds.
+// Providers that fail repeatedly are skipped for a cooldown period, unless every provider is unhealthy.
+type failoverSender struct {
+	providers []*provider
+	logger    *zap.Logger
+}
+
+func newFailoverSender(providers []*provider, logger *zap.Logger) *failoverSender {
+	return &failoverSender{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+func (f *failoverSender) Send(ctx context.Context, msg *Message) error {
+	now := time.Now()
+
+	// healthy providers first, then unhealthy ones as a last resort; both in priority order
+	candidates := make([]*provider, 0, len(f.providers))
+	var unhealthy []*provider
+	for _, p := range f.providers {
+		if p.healthy(now) {
+			candidates = append(candidates, p)
+		} else {
+			unhealthy = append(unhealthy, p)
+		}
+	}
+	candidates = append(candidates, unhealthy...)
+
+	var errs []error
+	for _, p := range candidates {
+		if p.limiter != nil {
+			if err := p.limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		err := p.sender.Send(ctx, msg)
+		if err == nil {
+			p.recordSuccess()
+			return nil
+		}
+		if ctx.Err() != nil {
+			// the caller gave up; that says nothing about the provider
+			return err
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", p.name, err))
+		if cooldown := p.recordFailure(time.Now()); cooldown > 0 {
+			f.logger.Warn("Mail provider marked unhealthy",
+				zap.String("provider", p.name),
+				zap.Duration("cooldown", cooldown),
+				zap.Error(err),
+			)
+		} else {
+			f.logger.Warn("Mail provider failed, trying next provider",
+				zap.String("provider", p.name),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return fmt.Errorf("all mail providers failed: %w", errors.Join(errs...))
+}
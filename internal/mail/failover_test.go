@@ -0,0 +1,107 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeSender struct {
+	err   error
+	calls int
+}
+
+func (f *fakeSender) Send(ctx context.Context, msg *Message) error {
+	f.calls++
+	return f.err
+}
+
+func Test_FailoverSender_FallsBack(t *testing.T) {
+	primary := &fakeSender{err: errors.New("boom")}
+	secondary := &fakeSender{}
+	f := newFailoverSender([]*provider{
+		newProvider("primary", primary, 0),
+		newProvider("secondary", secondary, 0),
+	}, zap.NewNop())
+
+	require.NoError(t, f.Send(context.Background(), &Message{}))
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 1, secondary.calls)
+}
+
+func Test_FailoverSender_SkipsUnhealthy(t *testing.T) {
+	primary := &fakeSender{err: errors.New("boom")}
+	secondary := &fakeSender{}
+	f := newFailoverSender([]*provider{
+		newProvider("primary", primary, 0),
+		newProvider("secondary", secondary, 0),
+	}, zap.NewNop())
+
+	for range unhealthyThreshold + 2 {
+		require.NoError(t, f.Send(context.Background(), &Message{}))
+	}
+	assert.Equal(t, unhealthyThreshold, primary.calls)
+	assert.Equal(t, unhealthyThreshold+2, secondary.calls)
+
+	// once the cooldown elapses, the primary is tried again
+	f.providers[0].unhealthyUntil = time.Now().Add(-time.Second)
+	primary.err = nil
+	require.NoError(t, f.Send(context.Background(), &Message{}))
+	assert.Equal(t, unhealthyThreshold+1, primary.calls)
+	assert.Equal(t, 0, f.providers[0].failures)
+}
+
+func Test_FailoverSender_AllFailed(t *testing.T) {
+	primary := &fakeSender{err: errors.New("primary down")}
+	secondary := &fakeSender{err: errors.New("secondary down")}
+	f := newFailoverSender([]*provider{
+		newProvider("primary", primary, 0),
+		newProvider("secondary", secondary, 0),
+	}, zap.NewNop())
+
+	// unhealthy providers are still tried when nothing else is left
+	for range unhealthyThreshold + 1 {
+		err := f.Send(context.Background(), &Message{})
+		assert.ErrorContains(t, err, "primary down")
+		assert.ErrorContains(t, err, "secondary down")
+	}
+	assert.Equal(t, unhealthyThreshold+1, primary.calls)
+	assert.Equal(t, unhealthyThreshold+1, secondary.calls)
+}
+
+func Test_New(t *testing.T) {
+	logger := zap.NewNop()
+
+	_, err := New(Config{Logger: logger})
+	assert.Error(t, err, "no provider configured outside of development mode")
+
+	_, err = New(Config{ResendAPIKey: "re_123", Logger: logger})
+	assert.Error(t, err, "resend is missing a from address")
+
+	_, err = New(Config{ResendAPIKey: "re_123", ResendFrom: "a@example.com", Providers: []string{"carrier-pigeon"}, Logger: logger})
+	assert.Error(t, err)
+
+	sender, err := New(Config{DevelopmentMode: true, Logger: logger})
+	require.NoError(t, err)
+	assert.IsType(t, &devSender{}, sender)
+
+	sender, err = New(Config{
+		Providers:    []string{ProviderSMTP, ProviderResend},
+		ResendAPIKey: "re_123",
+		ResendFrom:   "a@example.com",
+		SMTPHost:     "mail.example.com",
+		SMTPFrom:     "a@example.com",
+		Logger:       logger,
+	})
+	require.NoError(t, err)
+	require.IsType(t, &failoverSender{}, sender)
+	providers := sender.(*failoverSender).providers
+	require.Len(t, providers, 2)
+	assert.Equal(t, ProviderSMTP, providers[0].name)
+	assert.Equal(t, ProviderResend, providers[1].name)
+}
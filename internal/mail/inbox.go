@@ -0,0 +1,264 @@
+package mail
+
+import (
+	"encoding/json"
+	htmltemplate "html/template"
+	"mime"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Inbox captures messages sent in development mode so they can be viewed over HTTP
+type Inbox struct {
+	mu       sync.RWMutex
+	capacity int
+	messages []*InboxMessage // oldest first
+}
+
+// InboxMessage is a message captured by an Inbox
+type InboxMessage struct {
+	ID         string
+	ReceivedAt time.Time
+	Message    *Message
+}
+
+// NewInbox creates an inbox holding at most capacity messages, discarding the oldest when full
+func NewInbox(capacity int) *Inbox {
+	return &Inbox{
+		capacity: capacity,
+	}
+}
+
+// Add captures a message
+func (i *Inbox) Add(msg *Message) *InboxMessage {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	m := &InboxMessage{
+		ID:         uuid.New().String(),
+		ReceivedAt: time.Now(),
+		Message:    msg,
+	}
+	i.messages = append(i.messages, m)
+	if len(i.messages) > i.capacity {
+		i.messages = i.messages[len(i.messages)-i.capacity:]
+	}
+	return m
+}
+
+// List returns the captured messages, newest first
+func (i *Inbox) List() []*InboxMessage {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	messages := make([]*InboxMessage, 0, len(i.messages))
+	for j := len(i.messages) - 1; j >= 0; j-- {
+		messages = append(messages, i.messages[j])
+	}
+	return messages
+}
+
+// Get returns a captured message by ID, or nil
+func (i *Inbox) Get(id string) *InboxMessage {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, m := range i.messages {
+		if m.ID == id {
+			return m
+		}
+	}
+	return nil
+}
+
+// Clear discards all captured messages
+func (i *Inbox) Clear() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.messages = nil
+}
+
+// Handler serves the inbox under /dev/mail:
+//   - GET /dev/mail: HTML page listing messages
+//   - GET /dev/mail/messages: JSON list of messages
+//   - DELETE /dev/mail/messages: discard all messages
+//   - GET /dev/mail/messages/{id}: JSON message
+//   - GET /dev/mail/messages/{id}/html: the message's rendered HTML body
+//   - GET /dev/mail/messages/{id}/attachments/{index}: download an attachment
+func (i *Inbox) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /dev/mail", i.servePage)
+	mux.HandleFunc("GET /dev/mail/messages", func(w http.ResponseWriter, r *http.Request) {
+		messages := i.List()
+		views := make([]inboxMessageView, 0, len(messages))
+		for _, m := range messages {
+			views = append(views, newInboxMessageView(m))
+		}
+		writeJSON(w, views)
+	})
+	mux.HandleFunc("DELETE /dev/mail/messages", func(w http.ResponseWriter, r *http.Request) {
+		i.Clear()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /dev/mail/messages/{id}", func(w http.ResponseWriter, r *http.Request) {
+		m := i.Get(r.PathValue("id"))
+		if m == nil {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, newInboxMessageView(m))
+	})
+	mux.HandleFunc("GET /dev/mail/messages/{id}/html", func(w http.ResponseWriter, r *http.Request) {
+		m := i.Get(r.PathValue("id"))
+		if m == nil {
+			http.NotFound(w, r)
+			return
+		}
+		// rendered in an iframe; don't let it run scripts
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(m.Message.HTML))
+	})
+	mux.HandleFunc("GET /dev/mail/messages/{id}/attachments/{index}", func(w http.ResponseWriter, r *http.Request) {
+		m := i.Get(r.PathValue("id"))
+		if m == nil {
+			http.NotFound(w, r)
+			return
+		}
+		index, err := strconv.Atoi(r.PathValue("index"))
+		if err != nil || index < 0 || index >= len(m.Message.Attachments) {
+			http.NotFound(w, r)
+			return
+		}
+		a := m.Message.Attachments[index]
+		w.Header().Set("Content-Type", a.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename}))
+		w.Write(a.Content)
+	})
+	return mux
+}
+
+type inboxMessageView struct {
+	ID          string                `json:"id"`
+	ReceivedAt  time.Time             `json:"receivedAt"`
+	To          []string              `json:"to"`
+	Subject     string                `json:"subject"`
+	HTML        string                `json:"html"`
+	Text        string                `json:"text"`
+	Headers     map[string]string     `json:"headers,omitempty"`
+	Tags        map[string]string     `json:"tags,omitempty"`
+	HTMLURL     string                `json:"htmlUrl"`
+	Attachments []inboxAttachmentView `json:"attachments"`
+}
+
+type inboxAttachmentView struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int    `json:"size"`
+	URL         string `json:"url"`
+}
+
+func newInboxMessageView(m *InboxMessage) inboxMessageView {
+	v := inboxMessageView{
+		ID:          m.ID,
+		ReceivedAt:  m.ReceivedAt,
+		To:          m.Message.To,
+		Subject:     m.Message.Subject,
+		HTML:        m.Message.HTML,
+		Text:        m.Message.Text,
+		Headers:     m.Message.Headers,
+		Tags:        m.Message.Tags,
+		HTMLURL:     "/dev/mail/messages/" + m.ID + "/html",
+		Attachments: []inboxAttachmentView{},
+	}
+	for index, a := range m.Message.Attachments {
+		v.Attachments = append(v.Attachments, inboxAttachmentView{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Size:        len(a.Content),
+			URL:         "/dev/mail/messages/" + m.ID + "/attachments/" + strconv.Itoa(index),
+		})
+	}
+	return v
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+var inboxPage = htmltemplate.Must(htmltemplate.New("inbox").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>WatchClub Dev Inbox</title>
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; margin: 0; display: flex; height: 100vh; }
+        nav { width: 360px; overflow-y: auto; border-right: 1px solid #e0e0e0; }
+        nav a { display: block; padding: 12px 16px; border-bottom: 1px solid #f0f0f0; color: #333; text-decoration: none; }
+        nav a:hover, nav a.selected { background: #f0f9ff; }
+        nav small { color: #666; }
+        main { flex: 1; display: flex; flex-direction: column; }
+        header { padding: 16px; border-bottom: 1px solid #e0e0e0; }
+        iframe { flex: 1; border: none; }
+        pre { margin: 0; padding: 16px; white-space: pre-wrap; }
+        .empty { padding: 16px; color: #666; }
+    </style>
+</head>
+<body>
+    <nav>
+        <button style="margin: 12px 16px;" onclick="fetch('/dev/mail/messages', {method: 'DELETE'}).then(() => location.href = '/dev/mail')">Clear inbox</button>
+        {{range .Messages}}
+        <a href="/dev/mail?id={{.ID}}"{{if and $.Selected (eq .ID $.Selected.ID)}} class="selected"{{end}}>
+            <strong>{{.Message.Subject}}</strong><br>
+            <small>To: {{range $i, $to := .Message.To}}{{if $i}}, {{end}}{{$to}}{{end}} · {{.ReceivedAt.Format "Jan 2 15:04:05"}}</small>
+        </a>
+        {{else}}
+        <p class="empty">No messages yet.</p>
+        {{end}}
+    </nav>
+    <main>
+        {{with .Selected}}
+        <header>
+            <strong>{{.Message.Subject}}</strong><br>
+            <small>To: {{range $i, $to := .Message.To}}{{if $i}}, {{end}}{{$to}}{{end}}</small>
+            {{range $index, $a := .Message.Attachments}}
+            <br><a href="/dev/mail/messages/{{$.Selected.ID}}/attachments/{{$index}}">📎 {{$a.Filename}}</a> <small>({{len $a.Content}} bytes)</small>
+            {{end}}
+            <br><small><a href="/dev/mail/messages/{{.ID}}">JSON</a></small>
+        </header>
+        {{if .Message.HTML}}
+        <iframe sandbox src="/dev/mail/messages/{{.ID}}/html"></iframe>
+        {{else}}
+        <pre>{{.Message.Text}}</pre>
+        {{end}}
+        {{else}}
+        <p class="empty">Select a message.</p>
+        {{end}}
+    </main>
+</body>
+</html>
+`))
+
+func (i *Inbox) servePage(w http.ResponseWriter, r *http.Request) {
+	messages := i.List()
+	var selected *InboxMessage
+	if id := r.URL.Query().Get("id"); id != "" {
+		selected = i.Get(id)
+	} else if len(messages) > 0 {
+		selected = messages[0]
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	inboxPage.Execute(w, struct {
+		Messages []*InboxMessage
+		Selected *InboxMessage
+	}{
+		Messages: messages,
+		Selected: selected,
+	})
+}
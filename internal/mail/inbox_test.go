@@ -0,0 +1,85 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_Inbox_Handler(t *testing.T) {
+	inbox := NewInbox(10)
+	sender, err := New(Config{DevelopmentMode: true, DevInbox: inbox, Logger: zap.NewNop()})
+	require.NoError(t, err)
+	require.NoError(t, sender.Send(context.Background(), &Message{
+		To:      []string{"member@example.com"},
+		Subject: "<b>Club</b> started",
+		HTML:    "<p>Hi</p>",
+		Text:    "Hi",
+		Attachments: []Attachment{
+			{Filename: "Club.ics", ContentType: "text/calendar; charset=utf-8", Content: []byte("BEGIN:VCALENDAR")},
+		},
+	}))
+
+	server := httptest.NewServer(inbox.Handler())
+	defer server.Close()
+
+	get := func(path string) (*http.Response, string) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	resp, body := get("/dev/mail/messages")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var messages []inboxMessageView
+	require.NoError(t, json.Unmarshal([]byte(body), &messages))
+	require.Len(t, messages, 1)
+	m := messages[0]
+	assert.Equal(t, "<b>Club</b> started", m.Subject)
+	assert.Equal(t, "<p>Hi</p>", m.HTML)
+	require.Len(t, m.Attachments, 1)
+
+	resp, body = get("/dev/mail")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "&lt;b&gt;Club&lt;/b&gt; started")
+	assert.Contains(t, body, m.HTMLURL)
+
+	resp, body = get(m.HTMLURL)
+	assert.Equal(t, "sandbox", resp.Header.Get("Content-Security-Policy"))
+	assert.Equal(t, "<p>Hi</p>", body)
+
+	resp, body = get(m.Attachments[0].URL)
+	assert.Equal(t, "text/calendar; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=Club.ics`, resp.Header.Get("Content-Disposition"))
+	assert.Equal(t, "BEGIN:VCALENDAR", body)
+
+	resp, _ = get("/dev/mail/messages/" + m.ID + "/attachments/1")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+"/dev/mail/messages", nil)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, inbox.List())
+}
+
+func Test_Inbox_Capacity(t *testing.T) {
+	inbox := NewInbox(2)
+	inbox.Add(&Message{Subject: "1"})
+	inbox.Add(&Message{Subject: "2"})
+	inbox.Add(&Message{Subject: "3"})
+
+	messages := inbox.List()
+	require.Len(t, messages, 2)
+	assert.Equal(t, "3", messages[0].Message.Subject)
+	assert.Equal(t, "2", messages[1].Message.Subject)
+}
@@ -1,7 +1,31 @@
 package mail
 
+import "context"
+
 // Sender is an interface for sending mail
 type Sender interface {
-	SendLogin(to, userName, userID, baseURL string) error
-	SendClubStarted(to, userName, clubName, clubID, baseURL string, icsData []byte) error
+	// Send delivers a message, honoring cancellation and deadlines on ctx
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Message is a provider-agnostic email
+type Message struct {
+	To          []string
+	Subject     string
+	HTML        string
+	Text        string
+	Attachments []Attachment
+
+	// Headers are additional MIME headers (e.g., List-Unsubscribe)
+	Headers map[string]string
+
+	// Tags are key/value labels used by providers for analytics and logging
+	Tags map[string]string
+}
+
+// Attachment is a file attached to a Message
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
 }
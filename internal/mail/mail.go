@@ -0,0 +1,113 @@
+package mail
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Provider names, used to set the priority order of providers
+const (
+	ProviderResend = "resend"
+	ProviderSMTP   = "smtp"
+)
+
+// DefaultProviders is the priority order used when Config.Providers is empty
+var DefaultProviders = []string{ProviderResend, ProviderSMTP}
+
+// Config holds email configuration
+type Config struct {
+	// DevelopmentMode logs emails to console instead of sending
+	DevelopmentMode bool
+
+	// DevInbox captures emails in development mode (optional)
+	DevInbox *Inbox
+
+	// Providers is the priority order in which configured providers are tried.
+	// Providers that aren't configured are ignored.
+	Providers []string
+
+	// Resend configuration
+	ResendAPIKey    string
+	ResendFrom      string  // Email address to send from (e.g., "you@yourdomain.com")
+	ResendFromName  string  // Optional display name (e.g., "WatchClub")
+	ResendRateLimit float64 // Requests per second (0 means unlimited)
+
+	// SMTP configuration
+	SMTPHost      string
+	SMTPPort      int    // Defaults to the standard port for SMTPTLS
+	SMTPUsername  string // Optional; authentication is skipped if empty
+	SMTPPassword  string
+	SMTPAuth      string  // SMTPAuthPlain (default) or SMTPAuthLogin
+	SMTPTLS       string  // SMTPTLSStartTLS (default), SMTPTLSImplicit, or SMTPTLSNone
+	SMTPFrom      string  // Email address to send from
+	SMTPFromName  string  // Optional display name
+	SMTPRateLimit float64 // Messages per second (0 means unlimited)
+
+	Logger *zap.Logger
+}
+
+// New creates a new email sender.
+// Outside of development mode, every configured provider must be valid and at least one must be configured.
+func New(config Config) (Sender, error) {
+	if config.DevelopmentMode {
+		return &devSender{
+			logger: config.Logger,
+			inbox:  config.DevInbox,
+		}, nil
+	}
+
+	order := config.Providers
+	if len(order) == 0 {
+		order = DefaultProviders
+	}
+
+	var providers []*provider
+	var errs []error
+	for _, name := range order {
+		switch name {
+		case ProviderResend:
+			if config.ResendAPIKey == "" {
+				continue
+			}
+			sender, err := newResendSender(
+				config.ResendAPIKey,
+				config.ResendFrom,
+				config.ResendFromName,
+				config.Logger,
+			)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("resend: %w", err))
+				continue
+			}
+			providers = append(providers, newProvider(name, sender, config.ResendRateLimit))
+		case ProviderSMTP:
+			if config.SMTPHost == "" {
+				continue
+			}
+			sender, err := newSMTPSender(config)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("smtp: %w", err))
+				continue
+			}
+			providers = append(providers, newProvider(name, sender, config.SMTPRateLimit))
+		default:
+			errs = append(errs, fmt.Errorf("unknown mail provider: %s (supported: %s, %s)", name, ProviderResend, ProviderSMTP))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid mail configuration: %w", errors.Join(errs...))
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no mail provider configured; configure Resend or SMTP, or use development mode")
+	}
+
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, p.name)
+	}
+	config.Logger.Info("Mail providers configured", zap.Strings("providers", names))
+
+	return newFailoverSender(providers, config.Logger), nil
+}
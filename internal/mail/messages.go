@@ -0,0 +1,246 @@
+package mail
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// LoginParams describes a login link email
+type LoginParams struct {
+	To       string
+	UserName string
+	UserID   string
+	BaseURL  string
+}
+
+// Login builds the email containing a user's login link.
+// Login emails are transactional, so they have no unsubscribe link.
+func (r *Renderer) Login(p LoginParams) (*Message, error) {
+	layout := layoutData{BaseURL: p.BaseURL}
+	msg, err := r.render("login", layout, struct {
+		layoutData
+		UserName  string
+		LoginLink string
+	}{
+		layoutData: layout,
+		UserName:   p.UserName,
+		LoginLink:  fmt.Sprintf("%s#/login/%s", p.BaseURL, p.UserID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.To = []string{p.To}
+	return msg, nil
+}
+
+// ClubStartedParams describes the email sent to members when a club starts
+type ClubStartedParams struct {
+	To       string
+	UserID   string
+	UserName string
+	ClubName string
+	ClubID   string
+	BaseURL  string
+
+	// ICSData is the club's schedule, attached as a calendar file
+	ICSData []byte
+}
+
+// ClubStarted builds the email announcing that a club has started
+func (r *Renderer) ClubStarted(p ClubStartedParams) (*Message, error) {
+	layout := layoutData{
+		BaseURL: p.BaseURL,
+		UnsubscribeURL: r.unsubscribeURL(p.BaseURL, UnsubscribeToken{
+			UserID: p.UserID,
+			ClubID: p.ClubID,
+			Type:   v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED,
+		}),
+	}
+	msg, err := r.render("club_started", layout, struct {
+		layoutData
+		UserName string
+		ClubName string
+		ClubLink string
+	}{
+		layoutData: layout,
+		UserName:   p.UserName,
+		ClubName:   p.ClubName,
+		ClubLink:   fmt.Sprintf("%s#/club/%s", p.BaseURL, p.ClubID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.To = []string{p.To}
+	msg.Attachments = []Attachment{
+		{
+			Filename:    attachmentFilename(p.ClubName, ".ics"),
+			ContentType: "text/calendar; charset=utf-8; method=PUBLISH",
+			Content:     p.ICSData,
+		},
+	}
+	return msg, nil
+}
+
+// DigestParams describes the periodic summary of a user's clubs
+type DigestParams struct {
+	To       string
+	UserID   string
+	UserName string
+	BaseURL  string
+
+	// ThisWeek and NextWeek are the picks scheduled to start in each week, in order
+	ThisWeek []DigestPick
+	NextWeek []DigestPick
+
+	// AwaitingPicks are clubs that haven't started, where the user can still add picks
+	AwaitingPicks []DigestClub
+}
+
+// DigestPick is a scheduled pick in a digest
+type DigestPick struct {
+	ClubID     string
+	ClubName   string
+	Title      string
+	Year       int32
+	PickerName string
+	StartDate  time.Time
+}
+
+// DigestClub is a club in a digest
+type DigestClub struct {
+	ID        string
+	Name      string
+	StartDate time.Time
+}
+
+// Digest builds the periodic summary email
+func (r *Renderer) Digest(p DigestParams) (*Message, error) {
+	type pick struct {
+		DigestPick
+		ClubLink string
+	}
+	type club struct {
+		DigestClub
+		Link string
+	}
+	picks := func(in []DigestPick) []pick {
+		out := make([]pick, 0, len(in))
+		for _, dp := range in {
+			out = append(out, pick{DigestPick: dp, ClubLink: fmt.Sprintf("%s#/club/%s", p.BaseURL, dp.ClubID)})
+		}
+		return out
+	}
+	awaiting := make([]club, 0, len(p.AwaitingPicks))
+	for _, dc := range p.AwaitingPicks {
+		awaiting = append(awaiting, club{DigestClub: dc, Link: fmt.Sprintf("%s#/club/%s", p.BaseURL, dc.ID)})
+	}
+
+	layout := layoutData{
+		BaseURL: p.BaseURL,
+		UnsubscribeURL: r.unsubscribeURL(p.BaseURL, UnsubscribeToken{
+			UserID: p.UserID,
+			Type:   v1.NotificationType_NOTIFICATION_TYPE_DIGESTS,
+		}),
+	}
+	msg, err := r.render("digest", layout, struct {
+		layoutData
+		UserName      string
+		ThisWeek      []pick
+		NextWeek      []pick
+		AwaitingPicks []club
+	}{
+		layoutData:    layout,
+		UserName:      p.UserName,
+		ThisWeek:      picks(p.ThisWeek),
+		NextWeek:      picks(p.NextWeek),
+		AwaitingPicks: awaiting,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.To = []string{p.To}
+	return msg, nil
+}
+
+// ScheduleChangedParams describes the email sent to members when a club's schedule changes
+type ScheduleChangedParams struct {
+	To       string
+	UserID   string
+	UserName string
+	ClubName string
+	ClubID   string
+	BaseURL  string
+	Changes  []ScheduleChange
+
+	// ICSData is an iTIP message that updates or cancels the changed events
+	ICSData []byte
+	// ICSMethod is the iTIP method of ICSData, e.g. REQUEST or CANCEL
+	ICSMethod string
+}
+
+// ScheduleChange describes a change to a scheduled pick
+type ScheduleChange struct {
+	Title        string
+	Year         int32
+	OldStartDate time.Time
+	NewStartDate time.Time
+	// Cancelled means the pick was removed from the schedule
+	Cancelled bool
+	// Restored means the pick is back on the schedule, on NewStartDate, after its club was restored
+	Restored bool
+}
+
+// ScheduleChanged builds the email telling members that a club's schedule has changed
+func (r *Renderer) ScheduleChanged(p ScheduleChangedParams) (*Message, error) {
+	layout := layoutData{
+		BaseURL: p.BaseURL,
+		UnsubscribeURL: r.unsubscribeURL(p.BaseURL, UnsubscribeToken{
+			UserID: p.UserID,
+			ClubID: p.ClubID,
+			Type:   v1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_CHANGES,
+		}),
+	}
+	msg, err := r.render("schedule_changed", layout, struct {
+		layoutData
+		UserName string
+		ClubName string
+		ClubLink string
+		Changes  []ScheduleChange
+	}{
+		layoutData: layout,
+		UserName:   p.UserName,
+		ClubName:   p.ClubName,
+		ClubLink:   fmt.Sprintf("%s#/club/%s", p.BaseURL, p.ClubID),
+		Changes:    p.Changes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.To = []string{p.To}
+	msg.Attachments = []Attachment{
+		{
+			Filename:    attachmentFilename(p.ClubName, ".ics"),
+			ContentType: "text/calendar; charset=utf-8; method=" + p.ICSMethod,
+			Content:     p.ICSData,
+		},
+	}
+	return msg, nil
+}
+
+// attachmentFilename derives a safe filename from a user-provided name
+func attachmentFilename(name, ext string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20, strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = "watchclub"
+	}
+	return name + ext
+}
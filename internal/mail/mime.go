@@ -0,0 +1,162 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+)
+
+// buildMIMEMessage encodes msg as an RFC 5322 message with a MIME body.
+// The text and HTML bodies are sent as multipart/alternative, wrapped in multipart/mixed when there are attachments.
+func buildMIMEMessage(from netmail.Address, msg *Message, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	to := make([]string, 0, len(msg.To))
+	for _, addr := range msg.To {
+		to = append(to, (&netmail.Address{Address: addr}).String())
+	}
+
+	domain := "watchclub"
+	if at := strings.LastIndex(from.Address, "@"); at >= 0 {
+		domain = from.Address[at+1:]
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from.String())
+	header.Set("To", strings.Join(to, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header.Set("Date", now.Format(time.RFC1123Z))
+	header.Set("Message-ID", fmt.Sprintf("<%s@%s>", randomID(), domain))
+	header.Set("MIME-Version", "1.0")
+	for name, value := range msg.Headers {
+		header.Set(name, value)
+	}
+
+	var body bytes.Buffer
+	var contentType string
+	if len(msg.Attachments) == 0 {
+		ct, err := writeAlternative(&body, msg)
+		if err != nil {
+			return nil, err
+		}
+		contentType = ct
+	} else {
+		mixed := multipart.NewWriter(&body)
+		var alternative bytes.Buffer
+		ct, err := writeAlternative(&alternative, msg)
+		if err != nil {
+			return nil, err
+		}
+		part, err := mixed.CreatePart(textproto.MIMEHeader{"Content-Type": {ct}})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(alternative.Bytes()); err != nil {
+			return nil, err
+		}
+		for _, a := range msg.Attachments {
+			contentType := a.ContentType
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			part, err := mixed.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {contentType},
+				"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+				"Content-Transfer-Encoding": {"base64"},
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := writeBase64(part, a.Content); err != nil {
+				return nil, err
+			}
+		}
+		if err := mixed.Close(); err != nil {
+			return nil, err
+		}
+		contentType = "multipart/mixed; boundary=" + mixed.Boundary()
+	}
+	header.Set("Content-Type", contentType)
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+		}
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// writeAlternative writes the text and HTML bodies of msg as multipart/alternative parts, returning the Content-Type
+func writeAlternative(w io.Writer, msg *Message) (string, error) {
+	alternative := multipart.NewWriter(w)
+	bodies := []struct {
+		contentType string
+		content     string
+	}{
+		// least preferred first, per RFC 2046
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+	for _, b := range bodies {
+		if b.content == "" {
+			continue
+		}
+		part, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {b.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return "", err
+		}
+		qp := quotedprintable.NewWriter(part)
+		if _, err := qp.Write([]byte(b.content)); err != nil {
+			return "", err
+		}
+		if err := qp.Close(); err != nil {
+			return "", err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return "", err
+	}
+	return "multipart/alternative; boundary=" + alternative.Boundary(), nil
+}
+
+// writeBase64 writes data base64-encoded, wrapped at 76 characters per line
+func writeBase64(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 0 {
+		n := min(76, len(encoded))
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
+
+func randomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -1,10 +1,9 @@
 package mail
 
 import (
+	"context"
 	"fmt"
-	"time"
 
-	"github.com/cartermckinnon/watchclub/internal/util"
 	"github.com/resend/resend-go/v2"
 	"go.uber.org/zap"
 )
@@ -14,13 +13,10 @@ type resendSender struct {
 	client      *resend.Client
 	fromAddress string
 	fromName    string
-	baseURL     string
 	logger      *zap.Logger
-
-	lock util.RateLimitedMutex
 }
 
-func newResendSender(apiKey, fromAddress, fromName, baseURL string, logger *zap.Logger) (*resendSender, error) {
+func newResendSender(apiKey, fromAddress, fromName string, logger *zap.Logger) (*resendSender, error) {
 	if apiKey == "" {
 		return nil, fmt.Errorf("resend API key is required")
 	}
@@ -34,282 +30,56 @@ func newResendSender(apiKey, fromAddress, fromName, baseURL string, logger *zap.
 		client:      client,
 		fromAddress: fromAddress,
 		fromName:    fromName,
-		baseURL:     baseURL,
 		logger:      logger,
-		lock:        util.NewRateLimitedMutex(500 * time.Millisecond), // our limit is 2 requests/sec
 	}, nil
 }
 
-func (r *resendSender) SendLogin(to, userName, userID, baseURL string) error {
-	r.lock.Lock()
-	defer r.lock.Unlock()
-
-	if baseURL == "" {
-		baseURL = r.baseURL
+func (r *resendSender) Send(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	loginLink := fmt.Sprintf("%s#/login/%s", baseURL, userID)
-
 	// Build from address with optional name
 	from := r.fromAddress
 	if r.fromName != "" {
 		from = fmt.Sprintf("%s <%s>", r.fromName, r.fromAddress)
 	}
 
-	// Create HTML email body
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body {
-            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif;
-            line-height: 1.6;
-            color: #333;
-            max-width: 600px;
-            margin: 0 auto;
-            padding: 20px;
-        }
-        .container {
-            background: white;
-            border-radius: 8px;
-            padding: 32px;
-            box-shadow: 0 2px 10px rgba(0, 0, 0, 0.1);
-        }
-        h1 {
-            color: #667eea;
-            font-size: 24px;
-            margin-bottom: 24px;
-        }
-        .button {
-            display: inline-block;
-            padding: 12px 24px;
-            background: #667eea;
-            color: white !important;
-            text-decoration: none;
-            border-radius: 6px;
-            font-weight: 600;
-            margin: 24px 0;
-        }
-        .footer {
-            margin-top: 32px;
-            padding-top: 24px;
-            border-top: 1px solid #e0e0e0;
-            color: #666;
-            font-size: 14px;
-        }
-        .link {
-            color: #667eea;
-            word-break: break-all;
-        }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h1>🎬 WatchClub Login</h1>
-        <p>Hi %s,</p>
-        <p>Click the button below to log into your WatchClub account:</p>
-        <a href="%s" class="button">Log in to WatchClub</a>
-        <p>Or copy and paste this link into your browser:</p>
-        <p class="link">%s</p>
-        <div class="footer">
-            <p>This link will automatically log you in to your account.</p>
-            <p>If you didn't request this login link, you can safely ignore this email.</p>
-        </div>
-    </div>
-</body>
-</html>
-`, userName, loginLink, loginLink)
-
-	// Create plain text version as fallback
-	textBody := fmt.Sprintf(`
-WatchClub Login
-
-Hi %s,
-
-Click the link below to log into your account:
-
-%s
-
-This link will automatically log you in.
-
-If you didn't request this login link, you can safely ignore this email.
-`, userName, loginLink)
-
-	// Send email using Resend API
 	params := &resend.SendEmailRequest{
 		From:    from,
-		To:      []string{to},
-		Subject: "Log in to WatchClub",
-		Html:    htmlBody,
-		Text:    textBody,
-	}
-
-	sent, err := r.client.Emails.Send(params)
-	if err != nil {
-		r.logger.Error("Failed to send email via Resend",
-			zap.String("to", to),
-			zap.Error(err),
-		)
-		return fmt.Errorf("failed to send email: %w", err)
-	}
-
-	r.logger.Info("📧 Login email sent via Resend",
-		zap.String("to", to),
-		zap.String("userName", userName),
-		zap.String("emailId", sent.Id),
-	)
-
-	return nil
-}
-
-func (r *resendSender) SendClubStarted(to, userName, clubName, clubID, baseURL string, icsData []byte) error {
-	r.lock.Lock()
-	defer r.lock.Unlock()
-
-	if baseURL == "" {
-		baseURL = r.baseURL
+		To:      msg.To,
+		Subject: msg.Subject,
+		Html:    msg.HTML,
+		Text:    msg.Text,
+		Headers: msg.Headers,
 	}
-
-	clubLink := fmt.Sprintf("%s#/club/%s", baseURL, clubID)
-
-	// Build from address with optional name
-	from := r.fromAddress
-	if r.fromName != "" {
-		from = fmt.Sprintf("%s <%s>", r.fromName, r.fromAddress)
+	for _, a := range msg.Attachments {
+		params.Attachments = append(params.Attachments, &resend.Attachment{
+			Filename:    a.Filename,
+			Content:     a.Content,
+			ContentType: a.ContentType,
+		})
 	}
-
-	// Create HTML email body
-	htmlBody := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body {
-            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif;
-            line-height: 1.6;
-            color: #333;
-            max-width: 600px;
-            margin: 0 auto;
-            padding: 20px;
-        }
-        .container {
-            background: white;
-            border-radius: 8px;
-            padding: 32px;
-            box-shadow: 0 2px 10px rgba(0, 0, 0, 0.1);
-        }
-        h1 {
-            color: #3b82f6;
-            font-size: 24px;
-            margin-bottom: 24px;
-        }
-        .button {
-            display: inline-block;
-            padding: 12px 24px;
-            background: #3b82f6;
-            color: white !important;
-            text-decoration: none;
-            border-radius: 6px;
-            font-weight: 600;
-            margin: 24px 0;
-        }
-        .info-box {
-            background: #f0f9ff;
-            border-left: 4px solid #3b82f6;
-            padding: 16px;
-            margin: 24px 0;
-            border-radius: 4px;
-        }
-        .footer {
-            margin-top: 32px;
-            padding-top: 24px;
-            border-top: 1px solid #e0e0e0;
-            color: #666;
-            font-size: 14px;
-        }
-        .link {
-            color: #3b82f6;
-            word-break: break-all;
-        }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h1>🎬 %s is Starting!</h1>
-        <p>Hi %s,</p>
-        <p>Great news! The club <strong>%s</strong> has started and the schedule is ready!</p>
-        <a href="%s" class="button">View Schedule</a>
-        <div class="info-box">
-            <strong>📅 Calendar Attached</strong><br>
-            The full schedule is attached as a calendar file (ICS). You can import it into:
-            <ul>
-                <li>Google Calendar</li>
-                <li>Apple Calendar</li>
-                <li>Outlook</li>
-                <li>Any other calendar app</li>
-            </ul>
-        </div>
-        <p>Each pick in the schedule includes details about who chose it and when to watch.</p>
-        <div class="footer">
-            <p>Happy watching! 🍿</p>
-        </div>
-    </div>
-</body>
-</html>
-`, clubName, userName, clubName, clubLink)
-
-	// Create plain text version
-	textBody := fmt.Sprintf(`
-%s is Starting!
-
-Hi %s,
-
-Great news! The club "%s" has started and the schedule is ready!
-
-View the schedule:
-%s
-
-📅 Calendar Attached
-The full schedule is attached as a calendar file (ICS). You can import it into Google Calendar, Apple Calendar, Outlook, or any other calendar app.
-
-Each pick in the schedule includes details about who chose it and when to watch.
-
-Happy watching! 🍿
-`, clubName, userName, clubName, clubLink)
-
-	// Send email with ICS attachment
-	params := &resend.SendEmailRequest{
-		From:    from,
-		To:      []string{to},
-		Subject: fmt.Sprintf("🎬 %s is Starting!", clubName),
-		Html:    htmlBody,
-		Text:    textBody,
-		Attachments: []*resend.Attachment{
-			{
-				Filename:    fmt.Sprintf("%s.ics", clubName),
-				Content:     icsData,
-				ContentType: "text/calendar; charset=utf-8; method=PUBLISH",
-			},
-		},
+	for name, value := range msg.Tags {
+		params.Tags = append(params.Tags, resend.Tag{Name: name, Value: value})
 	}
 
-	sent, err := r.client.Emails.Send(params)
+	sent, err := r.client.Emails.SendWithContext(ctx, params)
 	if err != nil {
-		r.logger.Error("Failed to send club started email via Resend",
-			zap.String("to", to),
-			zap.String("clubName", clubName),
+		r.logger.Error("Failed to send email via Resend",
+			zap.Strings("to", msg.To),
+			zap.String("subject", msg.Subject),
 			zap.Error(err),
 		)
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 
-	r.logger.Info("📧 Club started email sent via Resend",
-		zap.String("to", to),
-		zap.String("userName", userName),
-		zap.String("clubName", clubName),
+	r.logger.Info("📧 Email sent via Resend",
+		zap.Strings("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.Any("tags", msg.Tags),
+		zap.Int("attachments", len(msg.Attachments)),
 		zap.String("emailId", sent.Id),
-		zap.Int("icsDataSize", len(icsData)),
 	)
 
 	return nil
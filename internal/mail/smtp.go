@@ -0,0 +1,249 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SMTP TLS modes
+const (
+	// SMTPTLSStartTLS upgrades a plaintext connection with STARTTLS (usually port 587)
+	SMTPTLSStartTLS = "starttls"
+	// SMTPTLSImplicit connects over TLS from the start (usually port 465)
+	SMTPTLSImplicit = "implicit"
+	// SMTPTLSNone never uses TLS; only appropriate for a relay on a trusted network
+	SMTPTLSNone = "none"
+)
+
+// SMTP authentication mechanisms
+const (
+	SMTPAuthPlain = "plain"
+	SMTPAuthLogin = "login"
+)
+
+// smtpSender sends emails through an SMTP relay
+type smtpSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	auth     string
+	tlsMode  string
+	from     netmail.Address
+	logger   *zap.Logger
+
+	// tlsConfig is used for STARTTLS and implicit TLS connections; nil uses the defaults for host
+	tlsConfig *tls.Config
+}
+
+func newSMTPSender(config Config) (*smtpSender, error) {
+	if config.SMTPHost == "" {
+		return nil, fmt.Errorf("SMTP host is required")
+	}
+	if config.SMTPFrom == "" {
+		return nil, fmt.Errorf("from address is required")
+	}
+
+	tlsMode := config.SMTPTLS
+	if tlsMode == "" {
+		tlsMode = SMTPTLSStartTLS
+	}
+	port := config.SMTPPort
+	switch tlsMode {
+	case SMTPTLSStartTLS:
+		if port == 0 {
+			port = 587
+		}
+	case SMTPTLSImplicit:
+		if port == 0 {
+			port = 465
+		}
+	case SMTPTLSNone:
+		if port == 0 {
+			port = 25
+		}
+	default:
+		return nil, fmt.Errorf("unsupported SMTP TLS mode: %s (supported: %s, %s, %s)", tlsMode, SMTPTLSStartTLS, SMTPTLSImplicit, SMTPTLSNone)
+	}
+
+	auth := config.SMTPAuth
+	if auth == "" {
+		auth = SMTPAuthPlain
+	}
+	if auth != SMTPAuthPlain && auth != SMTPAuthLogin {
+		return nil, fmt.Errorf("unsupported SMTP auth mechanism: %s (supported: %s, %s)", auth, SMTPAuthPlain, SMTPAuthLogin)
+	}
+
+	return &smtpSender{
+		host:     config.SMTPHost,
+		port:     port,
+		username: config.SMTPUsername,
+		password: config.SMTPPassword,
+		auth:     auth,
+		tlsMode:  tlsMode,
+		from:     netmail.Address{Name: config.SMTPFromName, Address: config.SMTPFrom},
+		logger:   config.Logger,
+	}, nil
+}
+
+func (s *smtpSender) Send(ctx context.Context, msg *Message) error {
+	data, err := buildMIMEMessage(s.from, msg, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+
+	if err := s.send(ctx, msg.To, data); err != nil {
+		s.logger.Error("Failed to send email via SMTP",
+			zap.Strings("to", msg.To),
+			zap.String("subject", msg.Subject),
+			zap.String("host", s.host),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	s.logger.Info("📧 Email sent via SMTP",
+		zap.Strings("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.Any("tags", msg.Tags),
+		zap.Int("attachments", len(msg.Attachments)),
+		zap.String("host", s.host),
+	)
+
+	return nil
+}
+
+func (s *smtpSender) send(ctx context.Context, to []string, data []byte) error {
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+
+	var conn net.Conn
+	var err error
+	if s.tlsMode == SMTPTLSImplicit {
+		dialer := &tls.Dialer{Config: s.tlsConfigForHost()}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+
+	// the SMTP client has no notion of a context, so enforce it on the connection
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return withContextErr(ctx, err)
+	}
+	defer c.Close()
+
+	if s.tlsMode == SMTPTLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("server %s does not support STARTTLS", addr)
+		}
+		if err := c.StartTLS(s.tlsConfigForHost()); err != nil {
+			return withContextErr(ctx, fmt.Errorf("STARTTLS failed: %w", err))
+		}
+	}
+
+	if s.username != "" {
+		var auth smtp.Auth
+		switch s.auth {
+		case SMTPAuthLogin:
+			auth = &loginAuth{username: s.username, password: s.password, host: s.host}
+		default:
+			auth = smtp.PlainAuth("", s.username, s.password, s.host)
+		}
+		if err := c.Auth(auth); err != nil {
+			return withContextErr(ctx, fmt.Errorf("authentication failed: %w", err))
+		}
+	}
+
+	if err := c.Mail(s.from.Address); err != nil {
+		return withContextErr(ctx, err)
+	}
+	for _, rcpt := range to {
+		if err := c.Rcpt(rcpt); err != nil {
+			return withContextErr(ctx, fmt.Errorf("recipient %s rejected: %w", rcpt, err))
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return withContextErr(ctx, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return withContextErr(ctx, err)
+	}
+	if err := w.Close(); err != nil {
+		return withContextErr(ctx, err)
+	}
+	return withContextErr(ctx, c.Quit())
+}
+
+func (s *smtpSender) tlsConfigForHost() *tls.Config {
+	if s.tlsConfig != nil {
+		return s.tlsConfig
+	}
+	return &tls.Config{ServerName: s.host}
+}
+
+// withContextErr prefers the context's error when the connection was interrupted by cancellation
+func withContextErr(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return errors.Join(ctx.Err(), err)
+	}
+	return err
+}
+
+// loginAuth implements the non-standard but widely deployed LOGIN mechanism
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	// same policy as smtp.PlainAuth: never send credentials in the clear to a remote host
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch string(fromServer) {
+	case "Username:", "User Name\x00":
+		return []byte(a.username), nil
+	case "Password:", "Password\x00":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected LOGIN challenge: %q", fromServer)
+	}
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
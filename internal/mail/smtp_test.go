@@ -0,0 +1,303 @@
+package mail
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeSMTPServer is a minimal in-process SMTP server that records what it receives
+type fakeSMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	implicit  bool
+
+	mu       sync.Mutex
+	auth     []string
+	from     string
+	rcpt     []string
+	data     []byte
+	startTLS bool
+}
+
+func newFakeSMTPServer(t *testing.T, implicit bool) *fakeSMTPServer {
+	cert := selfSignedCert(t)
+	s := &fakeSMTPServer{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+		implicit:  implicit,
+	}
+	var err error
+	if implicit {
+		s.listener, err = tls.Listen("tcp", "127.0.0.1:0", s.tlsConfig)
+	} else {
+		s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() { s.listener.Close() })
+	go func() {
+		for {
+			conn, err := s.listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 fake ESMTP")
+	_, secure := conn.(*tls.Conn)
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250-fake")
+			if !secure {
+				tp.PrintfLine("250-STARTTLS")
+			}
+			tp.PrintfLine("250 AUTH PLAIN LOGIN")
+		case "STARTTLS":
+			tp.PrintfLine("220 ready")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, secure = tlsConn, true
+			tp = textproto.NewConn(conn)
+			s.mu.Lock()
+			s.startTLS = true
+			s.mu.Unlock()
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			var creds string
+			switch mechanism {
+			case "PLAIN":
+				b, _ := base64.StdEncoding.DecodeString(initial)
+				creds = "PLAIN" + strings.ReplaceAll(string(b), "\x00", ":")
+			case "LOGIN":
+				tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Username:")))
+				user, _ := tp.ReadLine()
+				tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Password:")))
+				pass, _ := tp.ReadLine()
+				u, _ := base64.StdEncoding.DecodeString(user)
+				p, _ := base64.StdEncoding.DecodeString(pass)
+				creds = "LOGIN:" + string(u) + ":" + string(p)
+			}
+			s.mu.Lock()
+			s.auth = append(s.auth, creds)
+			s.mu.Unlock()
+			tp.PrintfLine("235 ok")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			s.mu.Unlock()
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			s.mu.Unlock()
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.data = data
+			s.mu.Unlock()
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 unknown command")
+		}
+	}
+}
+
+func selfSignedCert(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func newTestSMTPSender(t *testing.T, server *fakeSMTPServer, config Config) *smtpSender {
+	config.SMTPHost = "127.0.0.1"
+	config.SMTPPort = server.port()
+	config.SMTPFrom = "club@example.com"
+	config.SMTPFromName = "WatchClub"
+	config.Logger = zap.NewNop()
+	sender, err := newSMTPSender(config)
+	require.NoError(t, err)
+	sender.tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	return sender
+}
+
+func testMessage() *Message {
+	return &Message{
+		To:      []string{"member@example.com"},
+		Subject: "🎬 Movie Night is Starting!",
+		HTML:    "<p>Hi there</p>",
+		Text:    "Hi there",
+		Attachments: []Attachment{
+			{Filename: "Movie Night.ics", ContentType: "text/calendar; charset=utf-8; method=PUBLISH", Content: []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")},
+		},
+		Headers: map[string]string{"X-Test": "yes"},
+	}
+}
+
+func Test_SMTPSender_StartTLSLogin(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	sender := newTestSMTPSender(t, server, Config{
+		SMTPUsername: "user",
+		SMTPPassword: "secret",
+		SMTPAuth:     SMTPAuthLogin,
+	})
+
+	require.NoError(t, sender.Send(context.Background(), testMessage()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.True(t, server.startTLS)
+	assert.Equal(t, []string{"LOGIN:user:secret"}, server.auth)
+	assert.Equal(t, "club@example.com", server.from)
+	assert.Equal(t, []string{"member@example.com"}, server.rcpt)
+	assertMessage(t, server.data)
+}
+
+func Test_SMTPSender_ImplicitTLSPlain(t *testing.T) {
+	server := newFakeSMTPServer(t, true)
+	sender := newTestSMTPSender(t, server, Config{
+		SMTPUsername: "user",
+		SMTPPassword: "secret",
+		SMTPTLS:      SMTPTLSImplicit,
+	})
+
+	require.NoError(t, sender.Send(context.Background(), testMessage()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.False(t, server.startTLS)
+	assert.Equal(t, []string{"PLAIN:user:secret"}, server.auth)
+	assertMessage(t, server.data)
+}
+
+func Test_SMTPSender_NoTLSNoAuth(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	sender := newTestSMTPSender(t, server, Config{SMTPTLS: SMTPTLSNone})
+
+	require.NoError(t, sender.Send(context.Background(), testMessage()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.False(t, server.startTLS)
+	assert.Empty(t, server.auth)
+	assertMessage(t, server.data)
+}
+
+func Test_SMTPSender_ContextCanceled(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	sender := newTestSMTPSender(t, server, Config{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, sender.Send(ctx, testMessage()), context.Canceled)
+}
+
+func Test_NewSMTPSender_InvalidConfig(t *testing.T) {
+	_, err := newSMTPSender(Config{SMTPHost: "mail.example.com"})
+	assert.Error(t, err)
+	_, err = newSMTPSender(Config{SMTPHost: "mail.example.com", SMTPFrom: "a@example.com", SMTPTLS: "sometimes"})
+	assert.Error(t, err)
+	_, err = newSMTPSender(Config{SMTPHost: "mail.example.com", SMTPFrom: "a@example.com", SMTPAuth: "cram-md5"})
+	assert.Error(t, err)
+}
+
+// assertMessage parses a message produced from testMessage and checks its structure
+func assertMessage(t *testing.T, data []byte) {
+	msg, err := netmail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "🎬 Movie Night is Starting!", subject)
+	assert.Equal(t, `"WatchClub" <club@example.com>`, msg.Header.Get("From"))
+	assert.Equal(t, "<member@example.com>", msg.Header.Get("To"))
+	assert.Equal(t, "yes", msg.Header.Get("X-Test"))
+	assert.NotEmpty(t, msg.Header.Get("Message-Id"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	mixed := multipart.NewReader(msg.Body, params["boundary"])
+	alternative, err := mixed.NextPart()
+	require.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(alternative.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	bodies := multipart.NewReader(alternative, params["boundary"])
+	text, err := bodies.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", text.Header.Get("Content-Type"))
+	b, _ := io.ReadAll(text)
+	assert.Equal(t, "Hi there", string(b))
+	html, err := bodies.NextPart()
+	require.NoError(t, err)
+	b, _ = io.ReadAll(html)
+	assert.Equal(t, "<p>Hi there</p>", string(b))
+
+	attachment, err := mixed.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "Movie Night.ics", attachment.FileName())
+	assert.Equal(t, "text/calendar; charset=utf-8; method=PUBLISH", attachment.Header.Get("Content-Type"))
+	encoded, _ := io.ReadAll(attachment)
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", string(decoded))
+	_, err = mixed.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
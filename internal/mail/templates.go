@@ -0,0 +1,151 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+// emailTemplates are the names of the emails that can be rendered.
+// Each has a <name>.html.tmpl and a <name>.txt.tmpl file, which are
+// executed within layout.html.tmpl and layout.txt.tmpl respectively.
+var emailTemplates = []string{
+	"login",
+	"club_started",
+	"digest",
+	"schedule_changed",
+}
+
+// Renderer renders email messages from templates.
+// HTML bodies use html/template, so user-provided values are escaped.
+type Renderer struct {
+	html        map[string]*htmltemplate.Template
+	text        map[string]*texttemplate.Template
+	unsubscribe *UnsubscribeSigner
+}
+
+// layoutData is used by the layout templates, and is embedded in the data for each email
+type layoutData struct {
+	BaseURL string
+	// UnsubscribeURL is empty for emails that can't be unsubscribed from, like login links
+	UnsubscribeURL string
+}
+
+// NewRenderer parses the embedded email templates.
+// If overrideDir is non-empty, any template file found there replaces the embedded file of the same name.
+// Notification emails include links signed by unsubscribe.
+func NewRenderer(overrideDir string, unsubscribe *UnsubscribeSigner) (*Renderer, error) {
+	read := func(name string) (string, error) {
+		if overrideDir != "" {
+			data, err := os.ReadFile(filepath.Join(overrideDir, name))
+			if err == nil {
+				return string(data), nil
+			}
+			if !errors.Is(err, fs.ErrNotExist) {
+				return "", fmt.Errorf("failed to read template override %s: %w", name, err)
+			}
+		}
+		data, err := embeddedTemplates.ReadFile("templates/" + name)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template %s: %w", name, err)
+		}
+		return string(data), nil
+	}
+
+	htmlLayout, err := read("layout.html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	textLayout, err := read("layout.txt.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Renderer{
+		html:        make(map[string]*htmltemplate.Template),
+		text:        make(map[string]*texttemplate.Template),
+		unsubscribe: unsubscribe,
+	}
+	for _, name := range emailTemplates {
+		htmlSrc, err := read(name + ".html.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		h, err := htmltemplate.New("layout").Parse(htmlLayout)
+		if err == nil {
+			h, err = h.Parse(htmlSrc)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s.html.tmpl: %w", name, err)
+		}
+
+		textSrc, err := read(name + ".txt.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		t, err := texttemplate.New("layout").Parse(textLayout)
+		if err == nil {
+			t, err = t.Parse(textSrc)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s.txt.tmpl: %w", name, err)
+		}
+		if t.Lookup("subject") == nil {
+			return nil, fmt.Errorf("%s.txt.tmpl must define a \"subject\" template", name)
+		}
+
+		r.html[name] = h
+		r.text[name] = t
+	}
+	return r, nil
+}
+
+// unsubscribeURL returns a signed unsubscribe link, or an empty string if the renderer has no signer
+func (r *Renderer) unsubscribeURL(baseURL string, t UnsubscribeToken) string {
+	if r.unsubscribe == nil {
+		return ""
+	}
+	return r.unsubscribe.URL(baseURL, t)
+}
+
+// render executes the named email's templates, returning a Message with the subject and bodies populated.
+// If layout has an UnsubscribeURL, the message gets List-Unsubscribe headers.
+func (r *Renderer) render(name string, layout layoutData, data any) (*Message, error) {
+	h, ok := r.html[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template: %s", name)
+	}
+	t := r.text[name]
+
+	var subject, htmlBody, textBody bytes.Buffer
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := h.ExecuteTemplate(&htmlBody, "layout", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML body: %w", name, err)
+	}
+	if err := t.ExecuteTemplate(&textBody, "layout", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s text body: %w", name, err)
+	}
+
+	msg := &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    htmlBody.String(),
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+		Tags:    map[string]string{"category": name},
+	}
+	if layout.UnsubscribeURL != "" {
+		setListUnsubscribe(msg, layout.UnsubscribeURL)
+	}
+	return msg, nil
+}
@@ -0,0 +1,133 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_Renderer_EscapesHTML(t *testing.T) {
+	r, err := NewRenderer("", nil)
+	require.NoError(t, err)
+
+	msg, err := r.ClubStarted(ClubStartedParams{
+		To:       "user@example.com",
+		UserName: `<img src=x onerror="alert(1)">`,
+		ClubName: "<script>alert(1)</script>",
+		ClubID:   "club-1",
+		BaseURL:  "https://watchclub.example.com/",
+		ICSData:  []byte("BEGIN:VCALENDAR"),
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, msg.HTML, "<script>")
+	assert.NotContains(t, msg.HTML, "<img")
+	assert.Contains(t, msg.HTML, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	assert.Contains(t, msg.HTML, `href="https://watchclub.example.com/#/club/club-1"`)
+	assert.Contains(t, msg.HTML, "color: #3b82f6;")
+	assert.Equal(t, "🎬 <script>alert(1)</script> is Starting!", msg.Subject)
+	assert.Contains(t, msg.Text, `The club "<script>alert(1)</script>" has started`)
+	assert.Equal(t, []string{"user@example.com"}, msg.To)
+	require.Len(t, msg.Attachments, 1)
+	assert.Equal(t, "_script_alert(1)__script_.ics", msg.Attachments[0].Filename)
+}
+
+func Test_Renderer_Login(t *testing.T) {
+	r, err := NewRenderer("", nil)
+	require.NoError(t, err)
+
+	msg, err := r.Login(LoginParams{
+		To:       "user@example.com",
+		UserName: "Jo",
+		UserID:   "user-1",
+		BaseURL:  "https://watchclub.example.com/",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Log in to WatchClub", msg.Subject)
+	assert.Contains(t, msg.HTML, "color: #667eea;")
+	assert.Contains(t, msg.HTML, "https://watchclub.example.com/#/login/user-1")
+	assert.Contains(t, msg.Text, "https://watchclub.example.com/#/login/user-1")
+	assert.Equal(t, "login", msg.Tags["category"])
+}
+
+func Test_Renderer_Override(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.txt.tmpl"),
+		[]byte(`{{define "subject"}}Welcome back, {{.UserName}}{{end}}{{define "content"}}Go to {{.LoginLink}}{{end}}`), 0o644))
+
+	r, err := NewRenderer(dir, nil)
+	require.NoError(t, err)
+
+	msg, err := r.Login(LoginParams{UserName: "Jo", UserID: "user-1", BaseURL: "http://localhost/"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Welcome back, Jo", msg.Subject)
+	assert.Contains(t, msg.Text, "Go to http://localhost/#/login/user-1")
+	// the HTML template was not overridden
+	assert.Contains(t, msg.HTML, "WatchClub Login")
+}
+
+func Test_Renderer_OverrideParseError(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.html.tmpl"), []byte(`{{define "content"}}`), 0o644))
+
+	_, err := NewRenderer(dir, nil)
+	assert.Error(t, err)
+}
+
+func Test_Renderer_Unsubscribe(t *testing.T) {
+	signer := NewUnsubscribeSigner([]byte("secret"))
+	r, err := NewRenderer("", signer)
+	require.NoError(t, err)
+
+	msg, err := r.ClubStarted(ClubStartedParams{
+		To:       "user@example.com",
+		UserID:   "user-1",
+		UserName: "Jo",
+		ClubName: "Movie Night",
+		ClubID:   "club-1",
+		BaseURL:  "https://watchclub.example.com/",
+	})
+	require.NoError(t, err)
+
+	unsubscribeURL := signer.URL("https://watchclub.example.com/", UnsubscribeToken{
+		UserID: "user-1",
+		ClubID: "club-1",
+		Type:   v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED,
+	})
+	assert.Equal(t, "<"+unsubscribeURL+">", msg.Headers["List-Unsubscribe"])
+	assert.Equal(t, "List-Unsubscribe=One-Click", msg.Headers["List-Unsubscribe-Post"])
+	assert.Contains(t, msg.Text, unsubscribeURL)
+	assert.Contains(t, msg.HTML, `href="`+strings.ReplaceAll(unsubscribeURL, "&", "&amp;")+`"`)
+
+	// login emails are transactional
+	msg, err = r.Login(LoginParams{To: "user@example.com", UserID: "user-1", BaseURL: "https://watchclub.example.com/"})
+	require.NoError(t, err)
+	assert.Empty(t, msg.Headers)
+	assert.NotContains(t, msg.Text, "Unsubscribe")
+}
+
+func Test_UnsubscribeSigner(t *testing.T) {
+	signer := NewUnsubscribeSigner([]byte("secret"))
+	token := UnsubscribeToken{UserID: "user-1", ClubID: "club-1", Type: v1.NotificationType_NOTIFICATION_TYPE_DIGESTS}
+
+	verified, err := signer.Verify(signer.Sign(token))
+	require.NoError(t, err)
+	assert.Equal(t, token, *verified)
+
+	forged := NewUnsubscribeSigner([]byte("other")).Sign(UnsubscribeToken{UserID: "user-2"})
+	_, err = signer.Verify(forged)
+	assert.ErrorIs(t, err, ErrInvalidUnsubscribeToken)
+
+	for _, invalid := range []string{"", "abc", "abc.def", "!!.!!"} {
+		_, err = signer.Verify(invalid)
+		assert.ErrorIs(t, err, ErrInvalidUnsubscribeToken, invalid)
+	}
+}
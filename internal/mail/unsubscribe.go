@@ -0,0 +1,85 @@
+package mail
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// UnsubscribeToken identifies the notifications that an unsubscribe link disables
+type UnsubscribeToken struct {
+	UserID string `json:"u"`
+	// ClubID limits the unsubscribe to a single club; empty means every club
+	ClubID string              `json:"c,omitempty"`
+	Type   v1.NotificationType `json:"t"`
+}
+
+// ErrInvalidUnsubscribeToken is returned for tokens that are malformed or weren't signed with our key
+var ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+
+// UnsubscribeSigner signs and verifies unsubscribe tokens, so links can't be forged for other users
+type UnsubscribeSigner struct {
+	key []byte
+}
+
+// NewUnsubscribeSigner creates a signer using an HMAC-SHA256 key
+func NewUnsubscribeSigner(key []byte) *UnsubscribeSigner {
+	return &UnsubscribeSigner{key: key}
+}
+
+// Sign encodes t as a URL-safe token
+func (s *UnsubscribeSigner) Sign(t UnsubscribeToken) string {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		// only strings and an int32 are marshaled
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.mac(payload))
+}
+
+// Verify decodes a token created by Sign
+func (s *UnsubscribeSigner) Verify(token string) (*UnsubscribeToken, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.mac(payload)) {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+	t := &UnsubscribeToken{}
+	if err := json.Unmarshal(payload, t); err != nil || t.UserID == "" {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+	return t, nil
+}
+
+// URL returns the one-click unsubscribe link for t
+func (s *UnsubscribeSigner) URL(baseURL string, t UnsubscribeToken) string {
+	return strings.TrimSuffix(baseURL, "/") + "/unsubscribe?token=" + url.QueryEscape(s.Sign(t))
+}
+
+func (s *UnsubscribeSigner) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// setListUnsubscribe adds the List-Unsubscribe (RFC 2369) and one-click List-Unsubscribe-Post (RFC 8058) headers
+func setListUnsubscribe(msg *Message, unsubscribeURL string) {
+	if msg.Headers == nil {
+		msg.Headers = make(map[string]string)
+	}
+	msg.Headers["List-Unsubscribe"] = "<" + unsubscribeURL + ">"
+	msg.Headers["List-Unsubscribe-Post"] = "List-Unsubscribe=One-Click"
+}
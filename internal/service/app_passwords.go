@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// appPasswordUsedInterval limits how often an app password's last_used_at is saved
+const appPasswordUsedInterval = time.Hour
+
+// CreateAppPassword creates a password for signing in to the CalDAV endpoint
+func (s *WatchClubService) CreateAppPassword(ctx context.Context, req *v1.CreateAppPasswordRequest) (*v1.CreateAppPasswordResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+	if req.Name == "" {
+		return nil, invalidArgument("name", "is required")
+	}
+
+	user, err := s.storage.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate app password: %w", err)
+	}
+	// 4 groups of 8 characters that are easy to type on a phone
+	encoded := strings.ToLower(base32.StdEncoding.EncodeToString(secret))
+	password := encoded[0:8] + "-" + encoded[8:16] + "-" + encoded[16:24] + "-" + encoded[24:32]
+
+	appPassword := &v1.AppPassword{
+		Id:           uuid.New().String(),
+		UserId:       req.UserId,
+		Name:         req.Name,
+		PasswordHash: hashAppPassword(password),
+		CreatedAt:    timestamppb.Now(),
+	}
+	if err := s.storage.CreateAppPassword(ctx, appPassword); err != nil {
+		return nil, fmt.Errorf("failed to create app password: %w", err)
+	}
+
+	s.logger.Info("Created app password",
+		zap.String("userId", req.UserId),
+		zap.String("appPasswordId", appPassword.Id))
+
+	return &v1.CreateAppPasswordResponse{
+		AppPassword: redactAppPassword(appPassword),
+		Password:    password,
+		Username:    user.Email,
+		CaldavUrl:   strings.TrimSuffix(s.baseURL, "/") + caldavPrefix + "/",
+	}, nil
+}
+
+// ListAppPasswords lists a user's app passwords
+func (s *WatchClubService) ListAppPasswords(ctx context.Context, req *v1.ListAppPasswordsRequest) (*v1.ListAppPasswordsResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	appPasswords, err := s.storage.ListAppPasswords(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app passwords: %w", err)
+	}
+
+	redacted := make([]*v1.AppPassword, 0, len(appPasswords))
+	for _, appPassword := range appPasswords {
+		redacted = append(redacted, redactAppPassword(appPassword))
+	}
+
+	return &v1.ListAppPasswordsResponse{AppPasswords: redacted}, nil
+}
+
+// DeleteAppPassword revokes an app password
+func (s *WatchClubService) DeleteAppPassword(ctx context.Context, req *v1.DeleteAppPasswordRequest) (*v1.DeleteAppPasswordResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+	if req.AppPasswordId == "" {
+		return nil, invalidArgument("app_password_id", "is required")
+	}
+
+	appPasswords, err := s.storage.ListAppPasswords(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app passwords: %w", err)
+	}
+	found := false
+	for _, appPassword := range appPasswords {
+		if appPassword.Id == req.AppPasswordId {
+			found = true
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "app password not found")
+	}
+
+	if err := s.storage.DeleteAppPassword(ctx, req.AppPasswordId); err != nil {
+		return nil, fmt.Errorf("failed to delete app password: %w", err)
+	}
+
+	return &v1.DeleteAppPasswordResponse{Success: true}, nil
+}
+
+// authenticateAppPassword returns the user that an email address and app password belong to
+func (s *WatchClubService) authenticateAppPassword(ctx context.Context, email string, password string) (*v1.User, bool) {
+	user, err := s.storage.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, false
+	}
+
+	appPasswords, err := s.storage.ListAppPasswords(ctx, user.Id)
+	if err != nil {
+		s.logger.Error("Failed to list app passwords", zap.String("userId", user.Id), zap.Error(err))
+		return nil, false
+	}
+
+	hash := []byte(hashAppPassword(password))
+	for _, appPassword := range appPasswords {
+		if subtle.ConstantTimeCompare(hash, []byte(appPassword.PasswordHash)) != 1 {
+			continue
+		}
+
+		if appPassword.LastUsedAt == nil || time.Since(appPassword.LastUsedAt.AsTime()) > appPasswordUsedInterval {
+			used := proto.Clone(appPassword).(*v1.AppPassword)
+			used.LastUsedAt = timestamppb.Now()
+			if err := s.storage.PutAppPassword(ctx, used); err != nil {
+				s.logger.Warn("Failed to save app password last use", zap.String("appPasswordId", used.Id), zap.Error(err))
+			}
+		}
+		return user, true
+	}
+	return nil, false
+}
+
+// hashAppPassword hashes an app password, ignoring case and separators.
+// App passwords are random, so a fast hash is enough.
+func hashAppPassword(password string) string {
+	password = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(password))
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+// redactAppPassword returns a copy of an app password without its hash
+func redactAppPassword(appPassword *v1.AppPassword) *v1.AppPassword {
+	redacted := proto.Clone(appPassword).(*v1.AppPassword)
+	redacted.PasswordHash = ""
+	return redacted
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+	"go.uber.org/zap"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// caldavPrefix is where the CalDAV endpoint is served.
+// Each user's clubs are calendars under /dav/<user id>/calendars/<club id>/,
+// with an object for each scheduled pick.
+const caldavPrefix = "/dav"
+
+var errCalDAVReadOnly = webdav.NewHTTPError(http.StatusForbidden, errors.New("calendars are read-only"))
+
+type caldavUserKey struct{}
+
+// CalDAVHandler serves a read-only CalDAV endpoint with each of the user's clubs as a calendar.
+// Users sign in with their email address and an app password.
+func (s *WatchClubService) CalDAVHandler() http.Handler {
+	handler := &caldav.Handler{
+		Backend: &caldavBackend{svc: s},
+		Prefix:  caldavPrefix,
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email, password, ok := r.BasicAuth()
+		if ok {
+			var user *v1.User
+			if user, ok = s.authenticateAppPassword(r.Context(), email, password); ok {
+				r = r.WithContext(context.WithValue(r.Context(), caldavUserKey{}, user))
+			}
+		}
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Basic realm="WatchClub", charset="UTF-8"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// caldavBackend exposes clubs' schedules to caldav.Handler
+type caldavBackend struct {
+	svc *WatchClubService
+}
+
+var _ caldav.Backend = &caldavBackend{}
+
+func caldavUser(ctx context.Context) (*v1.User, error) {
+	user, ok := ctx.Value(caldavUserKey{}).(*v1.User)
+	if !ok {
+		return nil, webdav.NewHTTPError(http.StatusUnauthorized, errors.New("not signed in"))
+	}
+	return user, nil
+}
+
+func (b *caldavBackend) CurrentUserPrincipal(ctx context.Context) (string, error) {
+	user, err := caldavUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	return caldavPrefix + "/" + user.Id + "/", nil
+}
+
+func (b *caldavBackend) CalendarHomeSetPath(ctx context.Context) (string, error) {
+	user, err := caldavUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	return caldavPrefix + "/" + user.Id + "/calendars/", nil
+}
+
+func (b *caldavBackend) ListCalendars(ctx context.Context) ([]caldav.Calendar, error) {
+	user, err := caldavUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clubs, err := b.svc.storage.ListClubsForUser(ctx, user.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clubs: %w", err)
+	}
+
+	calendars := make([]caldav.Calendar, 0, len(clubs))
+	for _, club := range clubs {
+		calendars = append(calendars, caldavCalendar(user, club))
+	}
+	return calendars, nil
+}
+
+func (b *caldavBackend) GetCalendar(ctx context.Context, calendarPath string) (*caldav.Calendar, error) {
+	user, club, _, err := b.resolve(ctx, calendarPath)
+	if err != nil {
+		return nil, err
+	}
+	calendar := caldavCalendar(user, club)
+	return &calendar, nil
+}
+
+func (b *caldavBackend) GetCalendarObject(ctx context.Context, objectPath string, req *caldav.CalendarCompRequest) (*caldav.CalendarObject, error) {
+	user, club, name, err := b.resolve(ctx, objectPath)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, errors.New("not a calendar object"))
+	}
+
+	objects, err := b.objects(ctx, user, club)
+	if err != nil {
+		return nil, err
+	}
+	for i := range objects {
+		if path.Base(objects[i].Path) == name {
+			return &objects[i], nil
+		}
+	}
+	return nil, webdav.NewHTTPError(http.StatusNotFound, fmt.Errorf("calendar object not found: %s", name))
+}
+
+func (b *caldavBackend) ListCalendarObjects(ctx context.Context, calendarPath string, req *caldav.CalendarCompRequest) ([]caldav.CalendarObject, error) {
+	user, club, _, err := b.resolve(ctx, calendarPath)
+	if err != nil {
+		return nil, err
+	}
+	return b.objects(ctx, user, club)
+}
+
+func (b *caldavBackend) QueryCalendarObjects(ctx context.Context, calendarPath string, query *caldav.CalendarQuery) ([]caldav.CalendarObject, error) {
+	objects, err := b.ListCalendarObjects(ctx, calendarPath, &query.CompRequest)
+	if err != nil {
+		return nil, err
+	}
+	return caldav.Filter(query, objects)
+}
+
+func (b *caldavBackend) CreateCalendar(ctx context.Context, calendar *caldav.Calendar) error {
+	return errCalDAVReadOnly
+}
+
+func (b *caldavBackend) PutCalendarObject(ctx context.Context, objectPath string, calendar *ical.Calendar, opts *caldav.PutCalendarObjectOptions) (*caldav.CalendarObject, error) {
+	return nil, errCalDAVReadOnly
+}
+
+func (b *caldavBackend) DeleteCalendarObject(ctx context.Context, objectPath string) error {
+	return errCalDAVReadOnly
+}
+
+// resolve parses a calendar or calendar object path, returning the club it belongs to
+// and the object's name (empty for a calendar). Users can only see their own clubs.
+func (b *caldavBackend) resolve(ctx context.Context, p string) (*v1.User, *v1.Club, string, error) {
+	user, err := caldavUser(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	notFound := webdav.NewHTTPError(http.StatusNotFound, fmt.Errorf("not found: %s", p))
+
+	// <user id>/calendars/<club id>[/<object>]
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(p, caldavPrefix), "/"), "/")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] != user.Id || parts[1] != "calendars" {
+		return nil, nil, "", notFound
+	}
+
+	club, err := b.svc.storage.GetClub(ctx, parts[2])
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, "", notFound
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if !slices.Contains(club.MemberIds, user.Id) {
+		return nil, nil, "", notFound
+	}
+
+	name := ""
+	if len(parts) == 4 {
+		name = parts[3]
+	}
+	return user, club, name, nil
+}
+
+// objects creates a calendar object for each of a club's scheduled picks
+func (b *caldavBackend) objects(ctx context.Context, user *v1.User, club *v1.Club) ([]caldav.CalendarObject, error) {
+	if !club.Started {
+		return nil, nil
+	}
+
+	assignments, err := b.svc.storage.ListScheduledPicks(ctx, club.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled picks: %w", err)
+	}
+
+	userMap, err := b.svc.scheduleUsers(ctx, []*v1.Club{club}, assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	modified := clubModified(club)
+	events := b.svc.scheduleEvents(club, assignments, userMap, modified)
+
+	objects := make([]caldav.CalendarObject, 0, len(events))
+	for i, event := range events {
+		// CalDAV objects are plain calendars without an iTIP method
+		data, err := (&ics.Calendar{ProdID: icsProdID, Events: []*ics.Event{event}}).Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate calendar object: %w", err)
+		}
+		calendar, err := ical.NewDecoder(bytes.NewReader(data)).Decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse calendar object: %w", err)
+		}
+
+		// caldav.Handler serves objects by encoding the parsed calendar, so describe those bytes
+		var served bytes.Buffer
+		if err := ical.NewEncoder(&served).Encode(calendar); err != nil {
+			return nil, fmt.Errorf("failed to encode calendar object: %w", err)
+		}
+
+		sum := sha256.Sum256(served.Bytes())
+		objects = append(objects, caldav.CalendarObject{
+			Path:          caldavCalendarPath(user, club) + assignments[i].Id + ".ics",
+			ModTime:       modified,
+			ContentLength: int64(served.Len()),
+			ETag:          hex.EncodeToString(sum[:16]),
+			Data:          calendar,
+		})
+	}
+
+	b.svc.logger.Debug("Listed CalDAV calendar objects",
+		zap.String("userId", user.Id),
+		zap.String("clubId", club.Id),
+		zap.Int("objects", len(objects)))
+
+	return objects, nil
+}
+
+func caldavCalendarPath(user *v1.User, club *v1.Club) string {
+	return caldavPrefix + "/" + user.Id + "/calendars/" + club.Id + "/"
+}
+
+func caldavCalendar(user *v1.User, club *v1.Club) caldav.Calendar {
+	return caldav.Calendar{
+		Path:                  caldavCalendarPath(user, club),
+		Name:                  club.Name,
+		Description:           "WatchClub schedule for " + club.Name,
+		SupportedComponentSet: []string{ical.CompEvent},
+	}
+}
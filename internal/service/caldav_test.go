@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_CalDAV(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	started := startTestClub(t, svc)
+
+	mux := http.NewServeMux()
+	mux.Handle("/dav/", svc.CalDAVHandler())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	created, err := svc.CreateAppPassword(ctx, &v1.CreateAppPasswordRequest{UserId: "jo", Name: "phone"})
+	require.NoError(t, err)
+	assert.Equal(t, "jo@example.com", created.Username)
+	assert.Equal(t, "https://watchclub.example.com/dav/", created.CaldavUrl)
+	assert.Empty(t, created.AppPassword.PasswordHash)
+
+	// wrong passwords are rejected
+	client, err := caldav.NewClient(webdav.HTTPClientWithBasicAuth(server.Client(), "jo@example.com", "wrong"), server.URL+"/dav/")
+	require.NoError(t, err)
+	_, err = client.FindCurrentUserPrincipal(ctx)
+	assert.Error(t, err)
+
+	client, err = caldav.NewClient(webdav.HTTPClientWithBasicAuth(server.Client(), created.Username, created.Password), server.URL+"/dav/")
+	require.NoError(t, err)
+
+	principal, err := client.FindCurrentUserPrincipal(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "/dav/jo/", principal)
+
+	homeSet, err := client.FindCalendarHomeSet(ctx, principal)
+	require.NoError(t, err)
+	assert.Equal(t, "/dav/jo/calendars/", homeSet)
+
+	calendars, err := client.FindCalendars(ctx, homeSet)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	assert.Equal(t, "/dav/jo/calendars/club/", calendars[0].Path)
+	assert.Equal(t, "Movie Night", calendars[0].Name)
+
+	// calendar-query for the first week of the schedule
+	objects, err := client.QueryCalendar(ctx, calendars[0].Path, &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{Name: ical.CompCalendar, AllProps: true, AllComps: true},
+		CompFilter: caldav.CompFilter{
+			Name: ical.CompCalendar,
+			Comps: []caldav.CompFilter{{
+				Name:  ical.CompEvent,
+				Start: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC),
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	first := started.Assignments[0]
+	assert.Equal(t, "/dav/jo/calendars/club/"+first.Id+".ics", objects[0].Path)
+	assert.NotEmpty(t, objects[0].ETag)
+	events := objects[0].Data.Events()
+	require.Len(t, events, 1)
+	uid, err := events[0].Props.Text(ical.PropUID)
+	require.NoError(t, err)
+	assert.Equal(t, first.CalendarUid, uid)
+
+	// calendar-multiget
+	paths := make([]string, 0, len(started.Assignments))
+	for _, assignment := range started.Assignments {
+		paths = append(paths, "/dav/jo/calendars/club/"+assignment.Id+".ics")
+	}
+	objects, err = client.MultiGetCalendar(ctx, calendars[0].Path, &caldav.CalendarMultiGet{
+		Paths:       paths,
+		CompRequest: caldav.CalendarCompRequest{Name: ical.CompCalendar, AllProps: true, AllComps: true},
+	})
+	require.NoError(t, err)
+	assert.Len(t, objects, 2)
+
+	// calendars are read-only
+	_, err = client.PutCalendarObject(ctx, "/dav/jo/calendars/club/new.ics", objects[0].Data)
+	assert.Error(t, err)
+
+	object, err := client.GetCalendarObject(ctx, "/dav/jo/calendars/club/"+first.Id+".ics")
+	require.NoError(t, err)
+	assert.NotEmpty(t, object.ETag)
+
+	// other users' calendars aren't visible
+	_, err = client.GetCalendarObject(ctx, "/dav/sam/calendars/club/"+first.Id+".ics")
+	assert.Error(t, err)
+
+	// revoked passwords stop working
+	_, err = svc.DeleteAppPassword(ctx, &v1.DeleteAppPasswordRequest{UserId: "jo", AppPasswordId: created.AppPassword.Id})
+	require.NoError(t, err)
+	_, err = client.FindCurrentUserPrincipal(ctx)
+	assert.Error(t, err)
+}
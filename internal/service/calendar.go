@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
+)
+
+// icsProdID identifies WatchClub as the product that created a calendar
+const icsProdID = "-//WatchClub//Schedule//EN"
+
+// calendarUID returns the UID of a scheduled pick's calendar event
+func calendarUID(assignment *v1.ScheduledPick) string {
+	if assignment.CalendarUid != "" {
+		return assignment.CalendarUid
+	}
+	// scheduled picks created before UIDs were stored used the pick's ID
+	return assignment.Pick.Id + "@watchclub"
+}
+
+// clubModified returns when a club or its schedule last changed
+func clubModified(club *v1.Club) time.Time {
+	// clubs created before updated_at was stored use their creation time
+	if club.UpdatedAt == nil {
+		return club.CreatedAt.AsTime()
+	}
+	return club.UpdatedAt.AsTime()
+}
+
+// newCalendar creates an empty calendar
+func newCalendar(method string, name string) *ics.Calendar {
+	return &ics.Calendar{
+		ProdID:          icsProdID,
+		Method:          method,
+		Name:            name,
+		DisplayTimezone: "UTC",
+	}
+}
+
+// scheduleUsers gets the members of clubs and the users who made scheduled picks in one lookup, by ID
+func (s *WatchClubService) scheduleUsers(ctx context.Context, clubs []*v1.Club, assignments []*v1.ScheduledPick) (map[string]*v1.User, error) {
+	var ids []string
+	for _, club := range clubs {
+		ids = append(ids, club.MemberIds...)
+	}
+	for _, assignment := range assignments {
+		ids = append(ids, assignment.Pick.UserId)
+	}
+
+	users, err := s.storage.GetUsers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	userMap := make(map[string]*v1.User, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
+	}
+	return userMap, nil
+}
+
+// scheduleEvents creates all-day calendar events spanning each scheduled pick's period, stamped with the given time
+func (s *WatchClubService) scheduleEvents(club *v1.Club, assignments []*v1.ScheduledPick, userMap map[string]*v1.User, stamp time.Time) []*ics.Event {
+	intervalDuration := calculateIntervalDuration(club.ScheduleIntervalQuantity, club.ScheduleIntervalUnit)
+
+	events := make([]*ics.Event, 0, len(assignments))
+	for _, assignment := range assignments {
+		pick := assignment.Pick
+		startDate := assignment.StartDate.AsTime()
+
+		// Get picker name
+		pickerName := "Unknown"
+		if user, ok := userMap[pick.UserId]; ok {
+			pickerName = user.Name
+		}
+
+		description := "Picked by " + pickerName
+		if pick.Notes != "" {
+			description += "\n\nNotes: " + pick.Notes
+		}
+		description += "\n\nView details: " + strings.TrimSuffix(s.baseURL, "/") + "/#/club/" + club.Id + "/pick/" + pick.Id
+
+		summary := pick.Title
+		if pick.Year > 0 {
+			summary += fmt.Sprintf(" (%d)", pick.Year)
+		}
+
+		events = append(events, &ics.Event{
+			UID:         calendarUID(assignment),
+			Sequence:    int(assignment.CalendarSequence),
+			Stamp:       stamp,
+			Start:       startDate,
+			End:         startDate.Add(intervalDuration),
+			AllDay:      true,
+			Summary:     summary,
+			Description: description,
+			Location:    pick.Link,
+			URL:         pick.Link,
+			Transparent: true,
+		})
+	}
+	return events
+}
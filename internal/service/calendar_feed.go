@@ -0,0 +1,232 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// GetCalendarFeed gets the URLs a user can subscribe to in their calendar app
+func (s *WatchClubService) GetCalendarFeed(ctx context.Context, req *v1.GetCalendarFeedRequest) (*v1.GetCalendarFeedResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	feed, err := s.storage.GetCalendarFeed(ctx, req.UserId)
+	if errors.Is(err, storage.ErrNotFound) {
+		// Create the user's feed the first time they ask for it
+		feed, err = s.newCalendarFeed(ctx, req.UserId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.calendarFeedResponse(feed), nil
+}
+
+// RotateCalendarFeedToken replaces a user's calendar feed token, revoking the old URLs
+func (s *WatchClubService) RotateCalendarFeedToken(ctx context.Context, req *v1.RotateCalendarFeedTokenRequest) (*v1.RotateCalendarFeedTokenResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	feed, err := s.newCalendarFeed(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Rotated calendar feed token", zap.String("userId", req.UserId))
+
+	return &v1.RotateCalendarFeedTokenResponse{Feed: s.calendarFeedResponse(feed)}, nil
+}
+
+// newCalendarFeed saves a new feed token for a user, replacing any existing one
+func (s *WatchClubService) newCalendarFeed(ctx context.Context, userID string) (*v1.CalendarFeed, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+
+	feed := &v1.CalendarFeed{
+		UserId:    userID,
+		Token:     base64.RawURLEncoding.EncodeToString(token),
+		CreatedAt: timestamppb.Now(),
+	}
+	if err := s.storage.PutCalendarFeed(ctx, feed); err != nil {
+		return nil, fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+	return feed, nil
+}
+
+func (s *WatchClubService) calendarFeedResponse(feed *v1.CalendarFeed) *v1.GetCalendarFeedResponse {
+	base := strings.TrimSuffix(s.baseURL, "/") + "/calendar/"
+	userURL := base + "user/" + feed.Token + ".ics"
+
+	webcalURL := userURL
+	if i := strings.Index(webcalURL, "://"); i >= 0 {
+		webcalURL = "webcal" + webcalURL[i:]
+	}
+
+	return &v1.GetCalendarFeedResponse{
+		Token:           feed.Token,
+		Url:             userURL,
+		WebcalUrl:       webcalURL,
+		ClubUrlTemplate: base + "club/{club_id}.ics?token=" + feed.Token,
+	}
+}
+
+// CalendarFeedHandler serves calendar subscriptions:
+//
+//	/calendar/user/<token>.ics         all of the user's clubs
+//	/calendar/club/<id>.ics?token=...  a single club the user is a member of
+//
+// Responses have an ETag of their content, so calendar apps can poll cheaply with conditional requests. There's no
+// Last-Modified header, because removing a club's events (resetting, deleting, or leaving it) doesn't move any
+// timestamp forward, and clients revalidating with If-Modified-Since would keep the removed events.
+func (s *WatchClubService) CalendarFeedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		kind, name, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/calendar/"), "/")
+		id, isICS := strings.CutSuffix(name, ".ics")
+		if !ok || !isICS || id == "" || strings.Contains(id, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		var (
+			calendarName string
+			clubs        []*v1.Club
+		)
+		switch kind {
+		case "user":
+			feed, err := s.storage.GetCalendarFeedByToken(r.Context(), id)
+			if err != nil {
+				s.calendarFeedError(w, r, err)
+				return
+			}
+			clubs, err = s.storage.ListClubsForUser(r.Context(), feed.UserId)
+			if err != nil {
+				s.logger.Error("Failed to list clubs for calendar feed", zap.String("userId", feed.UserId), zap.Error(err))
+				http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+				return
+			}
+			calendarName = "WatchClub"
+		case "club":
+			feed, err := s.storage.GetCalendarFeedByToken(r.Context(), r.URL.Query().Get("token"))
+			if err != nil {
+				s.calendarFeedError(w, r, err)
+				return
+			}
+			club, err := s.storage.GetClub(r.Context(), id)
+			if err != nil {
+				s.calendarFeedError(w, r, err)
+				return
+			}
+			if !slices.Contains(club.MemberIds, feed.UserId) {
+				http.NotFound(w, r)
+				return
+			}
+			clubs = []*v1.Club{club}
+			calendarName = club.Name + " - Schedule"
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		icsData, err := s.generateCalendarFeed(r.Context(), calendarName, clubs)
+		if err != nil {
+			s.logger.Error("Failed to generate calendar feed", zap.String("path", r.URL.Path), zap.Error(err))
+			http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+			return
+		}
+
+		sum := sha256.Sum256(icsData)
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+		// The URL is a secret, so shared caches must not store it
+		w.Header().Set("Cache-Control", "private, max-age=300")
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(icsData))
+	})
+}
+
+// calendarFeedError responds to a failed lookup, which is a 404 unless storage failed
+func (s *WatchClubService) calendarFeedError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, storage.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	s.logger.Error("Failed to load calendar feed", zap.String("path", r.URL.Path), zap.Error(err))
+	http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+}
+
+// generateCalendarFeed creates an ICS calendar of the started clubs' schedules.
+// The output only changes when the schedules do.
+func (s *WatchClubService) generateCalendarFeed(ctx context.Context, name string, clubs []*v1.Club) ([]byte, error) {
+	clubs = slices.Clone(clubs)
+	sort.Slice(clubs, func(i, j int) bool {
+		return clubs[i].Id < clubs[j].Id
+	})
+
+	calendar := newCalendar(ics.MethodPublish, name)
+	// Ask calendar apps to refresh hourly
+	calendar.RefreshInterval = time.Hour
+
+	var started []*v1.Club
+	var startedIDs []string
+	for _, club := range clubs {
+		if club.Started {
+			started = append(started, club)
+			startedIDs = append(startedIDs, club.Id)
+		}
+	}
+
+	// Load every club's schedule and pickers at once, rather than per club
+	assignments, err := s.storage.ListScheduledPicksForClubs(ctx, startedIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap, err := s.scheduleUsers(ctx, started, assignments)
+	if err != nil {
+		return nil, err
+	}
+	assignmentsByClub := make(map[string][]*v1.ScheduledPick)
+	for _, assignment := range assignments {
+		assignmentsByClub[assignment.ClubId] = append(assignmentsByClub[assignment.ClubId], assignment)
+	}
+
+	for _, club := range started {
+		calendar.Events = append(calendar.Events, s.scheduleEvents(club, assignmentsByClub[club.Id], userMap, clubModified(club))...)
+	}
+
+	return calendar.Marshal()
+}
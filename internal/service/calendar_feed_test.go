@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_CalendarFeedHandler(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	started := startTestClub(t, svc)
+	require.NoError(t, svc.storage.CreateUser(ctx, &v1.User{Id: "lee", Name: "Lee", Email: "lee@example.com"}))
+	handler := svc.CalendarFeedHandler()
+
+	get := func(target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	path := func(url string) string {
+		return strings.TrimPrefix(url, "https://watchclub.example.com")
+	}
+
+	feed, err := svc.GetCalendarFeed(ctx, &v1.GetCalendarFeedRequest{UserId: "jo"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://watchclub.example.com/calendar/user/"+feed.Token+".ics", feed.Url)
+	assert.Equal(t, "webcal://watchclub.example.com/calendar/user/"+feed.Token+".ics", feed.WebcalUrl)
+
+	again, err := svc.GetCalendarFeed(ctx, &v1.GetCalendarFeedRequest{UserId: "jo"})
+	require.NoError(t, err)
+	assert.Equal(t, feed.Token, again.Token)
+
+	// user feed
+	rec := get(path(feed.Url), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Empty(t, rec.Header().Get("Last-Modified"))
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	body := rec.Body.String()
+	assert.Contains(t, body, "X-WR-CALNAME:WatchClub\r\n")
+	for _, assignment := range started.Assignments {
+		assert.Contains(t, body, "UID:"+assignment.CalendarUid+"\r\n")
+	}
+
+	// unchanged feeds are identical and can be revalidated
+	assert.Equal(t, body, get(path(feed.Url), nil).Body.String())
+	rec = get(path(feed.Url), http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	// feeds can't be revalidated by time, which removing events doesn't move forward
+	rec = get(path(feed.Url), http.Header{"If-Modified-Since": {time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// club feed
+	clubURL := path(strings.Replace(feed.ClubUrlTemplate, "{club_id}", "club", 1))
+	rec = get(clubURL, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "X-WR-CALNAME:Movie Night - Schedule\r\n")
+
+	// only members can use a club's feed
+	leeFeed, err := svc.GetCalendarFeed(ctx, &v1.GetCalendarFeedRequest{UserId: "lee"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, get("/calendar/club/club.ics?token="+leeFeed.Token, nil).Code)
+	assert.Equal(t, http.StatusNotFound, get("/calendar/club/club.ics", nil).Code)
+	assert.Equal(t, http.StatusNotFound, get("/calendar/user/nope.ics", nil).Code)
+
+	// rotating the token revokes the old URLs
+	rotated, err := svc.RotateCalendarFeedToken(ctx, &v1.RotateCalendarFeedTokenRequest{UserId: "jo"})
+	require.NoError(t, err)
+	assert.NotEqual(t, feed.Token, rotated.Feed.Token)
+	assert.Equal(t, http.StatusNotFound, get(path(feed.Url), nil).Code)
+	assert.Equal(t, http.StatusNotFound, get(clubURL, nil).Code)
+	assert.Equal(t, http.StatusOK, get(path(rotated.Feed.Url), nil).Code)
+}
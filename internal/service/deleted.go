@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+)
+
+// defaultDeletedRetention is how long deleted clubs and picks can be restored when the config doesn't say
+const defaultDeletedRetention = 30 * 24 * time.Hour
+
+// ListDeletedClubs lists the deleted clubs a user is a member of that can still be restored, most recently deleted first
+func (s *WatchClubService) ListDeletedClubs(ctx context.Context, req *v1.ListDeletedClubsRequest) (*v1.ListDeletedClubsResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	clubs, err := s.restorableClubs(ctx, req.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(clubs, func(a, b *v1.Club) int {
+		return b.DeletedAt.AsTime().Compare(a.DeletedAt.AsTime())
+	})
+
+	return &v1.ListDeletedClubsResponse{
+		Clubs:         clubs,
+		RetentionDays: int32(s.deletedRetention / (24 * time.Hour)),
+	}, nil
+}
+
+// RestoreClub restores a deleted club for one of its members, putting its schedule back on members' calendars
+func (s *WatchClubService) RestoreClub(ctx context.Context, req *v1.RestoreClubRequest) (*v1.RestoreClubResponse, error) {
+	if req.ClubId == "" {
+		return nil, invalidArgument("club_id", "is required")
+	}
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	// only members can restore a club, and only until it's due to be purged
+	clubs, err := s.restorableClubs(ctx, req.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(clubs, func(club *v1.Club) bool { return club.Id == req.ClubId })
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, "deleted club not found")
+	}
+	club := clubs[i]
+	club.DeletedAt = nil
+
+	if err := s.storage.RestoreClub(ctx, club.Id); err != nil {
+		return nil, fmt.Errorf("failed to restore club: %w", err)
+	}
+
+	if club.Started {
+		if err := s.restoreSchedule(ctx, club); err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.Info("Club restored",
+		zap.String("clubId", club.Id),
+		zap.String("userId", req.UserId))
+
+	return &v1.RestoreClubResponse{Club: club}, nil
+}
+
+// restorableClubs gets the deleted clubs a user is a member of that were deleted within the retention period
+func (s *WatchClubService) restorableClubs(ctx context.Context, userID string, now time.Time) ([]*v1.Club, error) {
+	clubs, err := s.storage.ListDeletedClubs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted clubs: %w", err)
+	}
+	cutoff := now.Add(-s.deletedRetention)
+	return slices.DeleteFunc(clubs, func(club *v1.Club) bool {
+		return club.DeletedAt.AsTime().Before(cutoff)
+	}), nil
+}
+
+// ListDeletedPicks lists a user's deleted picks in a club that can still be restored, most recently deleted first
+func (s *WatchClubService) ListDeletedPicks(ctx context.Context, req *v1.ListDeletedPicksRequest) (*v1.ListDeletedPicksResponse, error) {
+	if req.ClubId == "" {
+		return nil, invalidArgument("club_id", "is required")
+	}
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if _, err := s.storage.GetClub(ctx, req.ClubId); err != nil {
+		return nil, err
+	}
+
+	picks, err := s.restorablePicks(ctx, req.ClubId, req.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(picks, func(a, b *v1.Pick) int {
+		return b.DeletedAt.AsTime().Compare(a.DeletedAt.AsTime())
+	})
+
+	return &v1.ListDeletedPicksResponse{
+		Picks:         picks,
+		RetentionDays: int32(s.deletedRetention / (24 * time.Hour)),
+	}, nil
+}
+
+// RestorePick restores one of a user's deleted picks (only allowed before club starts)
+func (s *WatchClubService) RestorePick(ctx context.Context, req *v1.RestorePickRequest) (*v1.RestorePickResponse, error) {
+	if req.PickId == "" {
+		return nil, invalidArgument("pick_id", "is required")
+	}
+	if req.ClubId == "" {
+		return nil, invalidArgument("club_id", "is required")
+	}
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	club, err := s.storage.GetClub(ctx, req.ClubId)
+	if err != nil {
+		return nil, err
+	}
+	if club.Started {
+		return nil, failedPrecondition(preconditionClubStarted, "clubs/"+club.Id, "cannot restore picks after club has started")
+	}
+
+	// users can only restore their own picks, and only until they're due to be purged
+	picks, err := s.restorablePicks(ctx, req.ClubId, req.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(picks, func(pick *v1.Pick) bool { return pick.Id == req.PickId })
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, "deleted pick not found")
+	}
+	pick := picks[i]
+	pick.DeletedAt = nil
+
+	// a restored pick counts against the limit like a new one
+	if err := s.checkPickLimit(ctx, club, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.RestorePick(ctx, pick.Id); err != nil {
+		return nil, fmt.Errorf("failed to restore pick: %w", err)
+	}
+
+	s.logger.Info("Pick restored",
+		zap.String("clubId", club.Id),
+		zap.String("pickId", pick.Id),
+		zap.String("userId", req.UserId))
+
+	return &v1.RestorePickResponse{Pick: pick}, nil
+}
+
+// restorablePicks gets a user's deleted picks in a club that were deleted within the retention period
+func (s *WatchClubService) restorablePicks(ctx context.Context, clubID string, userID string, now time.Time) ([]*v1.Pick, error) {
+	picks, err := s.storage.ListDeletedPicks(ctx, clubID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted picks: %w", err)
+	}
+	cutoff := now.Add(-s.deletedRetention)
+	return slices.DeleteFunc(picks, func(pick *v1.Pick) bool {
+		return pick.DeletedAt.AsTime().Before(cutoff)
+	}), nil
+}
+
+// restoreSchedule puts a restored club's schedule back on members' calendars, superseding the cancellations sent
+// when it was deleted
+func (s *WatchClubService) restoreSchedule(ctx context.Context, club *v1.Club) error {
+	assignments, err := s.storage.ListScheduledPicks(ctx, club.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get scheduled picks: %w", err)
+	}
+
+	changes := make([]mail.ScheduleChange, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignment.CalendarSequence++
+		if err := s.storage.UpdateScheduledPick(ctx, assignment); err != nil {
+			return fmt.Errorf("failed to update scheduled pick: %w", err)
+		}
+		changes = append(changes, mail.ScheduleChange{
+			Title:        assignment.Pick.Title,
+			Year:         assignment.Pick.Year,
+			NewStartDate: assignment.StartDate.AsTime(),
+			Restored:     true,
+		})
+	}
+	if err := s.touchClub(ctx, club); err != nil {
+		return err
+	}
+
+	if len(assignments) > 0 {
+		// Use background context since this runs in a goroutine after the RPC returns
+		go s.sendScheduleChangedEmails(context.Background(), proto.Clone(club).(*v1.Club), ics.MethodRequest, assignments, changes)
+	}
+	return nil
+}
+
+// RunPurge permanently deletes clubs and picks once they've been deleted for longer than the retention period,
+// until ctx is done, checking every interval
+func (s *WatchClubService) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.purgeDeleted(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeDeleted permanently deletes what was deleted before the retention period
+func (s *WatchClubService) purgeDeleted(ctx context.Context, now time.Time) {
+	purged, err := s.storage.PurgeDeleted(ctx, now.Add(-s.deletedRetention))
+	if err != nil {
+		s.logger.Error("Failed to purge deleted records", zap.Error(err))
+		return
+	}
+	if purged > 0 {
+		s.logger.Info("Purged deleted records", zap.Int("purged", purged))
+	}
+}
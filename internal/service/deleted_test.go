@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_RestoreClub(t *testing.T) {
+	ctx := context.Background()
+	svc, sender := newTestService(t)
+	started := startTestClub(t, svc)
+	require.NoError(t, svc.storage.CreateUser(ctx, &v1.User{Id: "al", Name: "Al", Email: "al@example.com"}))
+
+	_, err := svc.DeleteClub(ctx, &v1.DeleteClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	for _, ics := range waitForMessages(t, sender, "📅 Movie Night schedule update", 2) {
+		assert.Contains(t, ics, "METHOD:CANCEL\r\n")
+		assert.Contains(t, ics, "SEQUENCE:1\r\n")
+	}
+	_, err = svc.GetClub(ctx, &v1.GetClubRequest{ClubId: "club"})
+	assert.Error(t, err)
+
+	deleted, err := svc.ListDeletedClubs(ctx, &v1.ListDeletedClubsRequest{UserId: "sam"})
+	require.NoError(t, err)
+	require.Len(t, deleted.Clubs, 1)
+	assert.Equal(t, "club", deleted.Clubs[0].Id)
+	assert.Equal(t, int32(30), deleted.RetentionDays)
+	deleted, err = svc.ListDeletedClubs(ctx, &v1.ListDeletedClubsRequest{UserId: "al"})
+	require.NoError(t, err)
+	assert.Empty(t, deleted.Clubs)
+
+	// only members can restore a club
+	_, err = svc.RestoreClub(ctx, &v1.RestoreClubRequest{ClubId: "club", UserId: "al"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := svc.RestoreClub(ctx, &v1.RestoreClubRequest{ClubId: "club", UserId: "sam"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Club.DeletedAt)
+	club, err := svc.GetClub(ctx, &v1.GetClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	assert.Len(t, club.Picks, 2)
+
+	// the restored events supersede the cancellations
+	for _, ics := range waitForMessages(t, sender, "📅 Movie Night schedule update", 4)[2:] {
+		assert.Contains(t, ics, "METHOD:REQUEST\r\n")
+		assert.Contains(t, ics, "SEQUENCE:2\r\n")
+		assert.NotContains(t, ics, "STATUS:CANCELLED")
+		for _, assignment := range started.Assignments {
+			assert.Contains(t, ics, "UID:"+assignment.CalendarUid+"\r\n")
+		}
+	}
+
+	_, err = svc.RestoreClub(ctx, &v1.RestoreClubRequest{ClubId: "club", UserId: "sam"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func Test_PurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	startTestClub(t, svc)
+
+	_, err := svc.DeleteClub(ctx, &v1.DeleteClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+
+	// nothing is purged within the retention period
+	svc.purgeDeleted(ctx, time.Now())
+	deleted, err := svc.storage.ListDeletedClubs(ctx, "jo")
+	require.NoError(t, err)
+	assert.Len(t, deleted, 1)
+
+	// clubs past the retention period can't be restored, even before they're purged
+	later := time.Now().Add(svc.deletedRetention + time.Minute)
+	restorable, err := svc.restorableClubs(ctx, "jo", later)
+	require.NoError(t, err)
+	assert.Empty(t, restorable)
+
+	svc.purgeDeleted(ctx, later)
+	deleted, err = svc.storage.ListDeletedClubs(ctx, "jo")
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	_, err = svc.storage.GetPick(ctx, "heat")
+	assert.Error(t, err)
+}
+
+func Test_RestorePick(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	store := svc.storage
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, store.CreateClub(ctx, &v1.Club{
+		Id:                       "club",
+		Name:                     "Movie Night",
+		MemberIds:                []string{"jo", "sam"},
+		MaxPicksPerMember:        1,
+		StartDate:                timestamppb.New(time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)),
+		ScheduleIntervalQuantity: 1,
+		ScheduleIntervalUnit:     v1.ScheduleIntervalUnit_SCHEDULE_INTERVAL_UNIT_WEEKS,
+	}))
+	require.NoError(t, store.CreatePick(ctx, &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", Year: 1995}))
+
+	_, err := svc.DeletePick(ctx, &v1.DeletePickRequest{PickId: "heat", UserId: "jo"})
+	require.NoError(t, err)
+
+	deleted, err := svc.ListDeletedPicks(ctx, &v1.ListDeletedPicksRequest{ClubId: "club", UserId: "jo"})
+	require.NoError(t, err)
+	require.Len(t, deleted.Picks, 1)
+	assert.Equal(t, "heat", deleted.Picks[0].Id)
+	assert.Equal(t, int32(30), deleted.RetentionDays)
+	deleted, err = svc.ListDeletedPicks(ctx, &v1.ListDeletedPicksRequest{ClubId: "club", UserId: "sam"})
+	require.NoError(t, err)
+	assert.Empty(t, deleted.Picks)
+
+	// users can only restore their own picks
+	_, err = svc.RestorePick(ctx, &v1.RestorePickRequest{PickId: "heat", ClubId: "club", UserId: "sam"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// a restored pick counts against the limit
+	added, err := svc.AddPick(ctx, &v1.AddPickRequest{ClubId: "club", UserId: "jo", Title: "Alien"})
+	require.NoError(t, err)
+	_, err = svc.RestorePick(ctx, &v1.RestorePickRequest{PickId: "heat", ClubId: "club", UserId: "jo"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = svc.DeletePick(ctx, &v1.DeletePickRequest{PickId: added.Pick.Id, UserId: "jo"})
+	require.NoError(t, err)
+
+	resp, err := svc.RestorePick(ctx, &v1.RestorePickRequest{PickId: "heat", ClubId: "club", UserId: "jo"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Pick.DeletedAt)
+	pick, err := store.GetPick(ctx, "heat")
+	require.NoError(t, err)
+	assert.Equal(t, "Heat", pick.Title)
+
+	_, err = svc.RestorePick(ctx, &v1.RestorePickRequest{PickId: "heat", ClubId: "club", UserId: "jo"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// picks can't be restored once the club has started
+	_, err = svc.DeletePick(ctx, &v1.DeletePickRequest{PickId: "heat", UserId: "jo"})
+	require.NoError(t, err)
+	require.NoError(t, store.CreatePick(ctx, &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien", Year: 1979}))
+	_, err = svc.StartClub(ctx, &v1.StartClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	_, err = svc.RestorePick(ctx, &v1.RestorePickRequest{PickId: "heat", ClubId: "club", UserId: "jo"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+)
+
+// defaultDigestWeekday is used when a user hasn't chosen a day for their digest
+const defaultDigestWeekday = v1.Weekday_WEEKDAY_MONDAY
+
+// RunDigests sends weekly digest emails until ctx is done, checking for due digests every interval
+func (s *WatchClubService) RunDigests(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.sendDueDigests(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDueDigests sends a digest to every user whose digest day is today, unless they've already received one today
+func (s *WatchClubService) sendDueDigests(ctx context.Context, now time.Time) {
+	users, err := s.storage.ListUsers(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list users for digests", zap.Error(err))
+		return
+	}
+
+	digestsSent := 0
+	for _, user := range users {
+		sent, err := s.sendDigest(ctx, user, now)
+		if err != nil {
+			s.logger.Error("Failed to send digest",
+				zap.String("userId", user.Id),
+				zap.Error(err))
+			continue
+		}
+		if sent {
+			digestsSent++
+		}
+	}
+
+	if digestsSent > 0 {
+		s.logger.Info("Finished sending digests", zap.Int("digestsSent", digestsSent))
+	}
+}
+
+// sendDigest sends a digest to a user if one is due and there's anything to tell them about
+func (s *WatchClubService) sendDigest(ctx context.Context, user *v1.User, now time.Time) (bool, error) {
+	if user.Email == "" {
+		return false, nil
+	}
+	prefs := s.notificationPreferences(ctx, user.Id)
+	if !notificationEnabled(prefs, "", v1.NotificationType_NOTIFICATION_TYPE_DIGESTS) || !digestDue(prefs, now) {
+		return false, nil
+	}
+
+	params, err := s.buildDigest(ctx, user, prefs, now)
+	if err != nil {
+		return false, err
+	}
+	if len(params.ThisWeek) == 0 && len(params.NextWeek) == 0 && len(params.AwaitingPicks) == 0 {
+		return false, nil
+	}
+
+	msg, err := s.renderer.Digest(params)
+	if err != nil {
+		return false, fmt.Errorf("failed to render digest: %w", err)
+	}
+	if err := s.mailSender.Send(ctx, msg); err != nil {
+		return false, err
+	}
+
+	// reload, so that changes made while sending aren't lost
+	prefs = s.notificationPreferences(ctx, user.Id)
+	prefs.LastDigestSentAt = timestamppb.New(now)
+	if err := s.storage.PutNotificationPreferences(ctx, prefs); err != nil {
+		return true, fmt.Errorf("failed to record digest: %w", err)
+	}
+	return true, nil
+}
+
+// digestDue reports whether now (in UTC) is the user's digest day and they haven't received a digest yet today
+func digestDue(prefs *v1.NotificationPreferences, now time.Time) bool {
+	weekday := prefs.DigestWeekday
+	if weekday == v1.Weekday_WEEKDAY_UNSPECIFIED {
+		weekday = defaultDigestWeekday
+	}
+	now = now.UTC()
+	if time.Weekday(weekday-v1.Weekday_WEEKDAY_SUNDAY) != now.Weekday() {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return prefs.LastDigestSentAt == nil || prefs.LastDigestSentAt.AsTime().Before(today)
+}
+
+// buildDigest collects the picks starting this week and next week in each of the user's clubs,
+// and the clubs that are still waiting for the user's picks
+func (s *WatchClubService) buildDigest(ctx context.Context, user *v1.User, prefs *v1.NotificationPreferences, now time.Time) (mail.DigestParams, error) {
+	params := mail.DigestParams{
+		To:       user.Email,
+		UserID:   user.Id,
+		UserName: user.Name,
+		BaseURL:  s.baseURL,
+	}
+
+	now = now.UTC()
+	thisWeek := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	nextWeek := thisWeek.AddDate(0, 0, 7)
+	end := nextWeek.AddDate(0, 0, 7)
+
+	clubs, err := s.storage.ListClubsForUser(ctx, user.Id)
+	if err != nil {
+		return params, fmt.Errorf("failed to list clubs: %w", err)
+	}
+
+	var waiting, started []string
+	clubsByID := make(map[string]*v1.Club, len(clubs))
+	for _, club := range clubs {
+		if !notificationEnabled(prefs, club.Id, v1.NotificationType_NOTIFICATION_TYPE_DIGESTS) {
+			continue
+		}
+		clubsByID[club.Id] = club
+		if club.Started {
+			started = append(started, club.Id)
+		} else {
+			waiting = append(waiting, club.Id)
+		}
+	}
+
+	picks, err := s.storage.ListPicksForClubs(ctx, waiting)
+	if err != nil {
+		return params, fmt.Errorf("failed to list picks: %w", err)
+	}
+	userPicks := make(map[string]int)
+	for _, pick := range picks {
+		if pick.UserId == user.Id {
+			userPicks[pick.ClubId]++
+		}
+	}
+	for _, clubID := range waiting {
+		club := clubsByID[clubID]
+		if userPicks[clubID] == 0 || (club.MaxPicksPerMember > 0 && userPicks[clubID] < int(club.MaxPicksPerMember)) {
+			params.AwaitingPicks = append(params.AwaitingPicks, mail.DigestClub{
+				ID:        club.Id,
+				Name:      club.Name,
+				StartDate: club.StartDate.AsTime(),
+			})
+		}
+	}
+
+	assignments, err := s.storage.ListScheduledPicksForClubs(ctx, started)
+	if err != nil {
+		return params, fmt.Errorf("failed to list scheduled picks: %w", err)
+	}
+	assignments = slices.DeleteFunc(assignments, func(assignment *v1.ScheduledPick) bool {
+		startDate := assignment.StartDate.AsTime()
+		return startDate.Before(thisWeek) || !startDate.Before(end)
+	})
+	pickerIDs := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		pickerIDs = append(pickerIDs, assignment.Pick.UserId)
+	}
+	pickers, err := s.storage.GetUsers(ctx, pickerIDs)
+	if err != nil {
+		return params, fmt.Errorf("failed to get pickers: %w", err)
+	}
+	pickerNames := make(map[string]string, len(pickers))
+	for _, picker := range pickers {
+		pickerNames[picker.Id] = picker.Name
+	}
+
+	for _, assignment := range assignments {
+		club := clubsByID[assignment.ClubId]
+		startDate := assignment.StartDate.AsTime()
+		pick := mail.DigestPick{
+			ClubID:     club.Id,
+			ClubName:   club.Name,
+			Title:      assignment.Pick.Title,
+			Year:       assignment.Pick.Year,
+			PickerName: pickerNames[assignment.Pick.UserId],
+			StartDate:  startDate,
+		}
+		if startDate.Before(nextWeek) {
+			params.ThisWeek = append(params.ThisWeek, pick)
+		} else {
+			params.NextWeek = append(params.NextWeek, pick)
+		}
+	}
+
+	byStartDate := func(a, b mail.DigestPick) int {
+		return a.StartDate.Compare(b.StartDate)
+	}
+	slices.SortFunc(params.ThisWeek, byStartDate)
+	slices.SortFunc(params.NextWeek, byStartDate)
+	slices.SortFunc(params.AwaitingPicks, func(a, b mail.DigestClub) int {
+		return a.StartDate.Compare(b.StartDate)
+	})
+
+	return params, nil
+}
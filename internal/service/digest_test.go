@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_SendDueDigests(t *testing.T) {
+	ctx := context.Background()
+	svc, sender := newTestService(t)
+	store := svc.storage
+
+	// a Monday
+	now := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, store.CreateClub(ctx, &v1.Club{Id: "started", Name: "Movie Night", MemberIds: []string{"jo", "sam"}, Started: true}))
+	require.NoError(t, store.CreateClub(ctx, &v1.Club{Id: "pending", Name: "Horror Club", MemberIds: []string{"jo"}, StartDate: timestamppb.New(now.Add(10 * day)), MaxPicksPerMember: 1}))
+	for i, start := range []time.Time{now.Add(-7 * day), now.Add(day), now.Add(8 * day), now.Add(15 * day)} {
+		pick := &v1.Pick{Id: string(rune('a' + i)), ClubId: "started", UserId: "sam", Title: "Movie " + string(rune('A'+i)), Year: 1999}
+		require.NoError(t, store.CreatePick(ctx, pick))
+		require.NoError(t, store.CreateScheduledPick(ctx, &v1.ScheduledPick{
+			Id:             string(rune('a' + i)),
+			ClubId:         "started",
+			SequenceNumber: int32(i + 1),
+			StartDate:      timestamppb.New(start),
+			Pick:           pick,
+		}))
+	}
+
+	// Sam prefers Fridays
+	require.NoError(t, store.PutNotificationPreferences(ctx, &v1.NotificationPreferences{UserId: "sam", DigestWeekday: v1.Weekday_WEEKDAY_FRIDAY}))
+
+	svc.sendDueDigests(ctx, now)
+	require.Len(t, sender.messages, 1)
+	msg := sender.messages[0]
+	assert.Equal(t, []string{"jo@example.com"}, msg.To)
+	assert.Contains(t, msg.Text, "this week:\n\n- Movie B (1999) in Movie Night, starting Tuesday, March 3 (picked by Sam)")
+	assert.Contains(t, msg.Text, "next week:\n\n- Movie C (1999) in Movie Night, starting Tuesday, March 10 (picked by Sam)")
+	assert.NotContains(t, msg.Text, "Movie A")
+	assert.NotContains(t, msg.Text, "Movie D")
+	assert.Contains(t, msg.Text, "Waiting for your picks:\n\n- Horror Club starts Thursday, March 12")
+	assert.NotEmpty(t, msg.Headers["List-Unsubscribe"])
+
+	// only one digest per day
+	svc.sendDueDigests(ctx, now.Add(time.Hour))
+	assert.Len(t, sender.messages, 1)
+
+	// Sam's digest goes out on Friday
+	svc.sendDueDigests(ctx, now.Add(4*day))
+	require.Len(t, sender.messages, 2)
+	assert.Equal(t, []string{"sam@example.com"}, sender.messages[1].To)
+
+	// Jo opts out
+	require.NoError(t, store.PutNotificationPreferences(ctx, &v1.NotificationPreferences{UserId: "jo", DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_DIGESTS}}))
+	svc.sendDueDigests(ctx, now.Add(7*day))
+	assert.Len(t, sender.messages, 2)
+}
+
+func Test_UpdateNotificationPreferences_DigestWeekday(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	require.NoError(t, svc.storage.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+
+	_, err := svc.UpdateNotificationPreferences(ctx, &v1.UpdateNotificationPreferencesRequest{
+		Preferences: &v1.NotificationPreferences{UserId: "jo", DigestWeekday: v1.Weekday(42)},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := svc.UpdateNotificationPreferences(ctx, &v1.UpdateNotificationPreferencesRequest{
+		Preferences: &v1.NotificationPreferences{UserId: "jo", DigestWeekday: v1.Weekday_WEEKDAY_SATURDAY},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, v1.Weekday_WEEKDAY_SATURDAY, resp.Preferences.DigestWeekday)
+}
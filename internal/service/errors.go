@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// Types of precondition failures, in the details of FailedPrecondition errors
+const (
+	preconditionClubStarted    = "CLUB_STARTED"
+	preconditionClubNotStarted = "CLUB_NOT_STARTED"
+	preconditionPickLimit      = "PICK_LIMIT"
+	preconditionNoPicks        = "NO_PICKS"
+	preconditionConflict       = "CONFLICT"
+)
+
+// ErrorInterceptor translates the errors that RPCs return into gRPC statuses.
+// Storage errors get the matching code and a message that's safe to show, and statuses from the service are passed through.
+// Anything else is an internal error, which is logged and hidden from the client.
+func ErrorInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			err = statusError(ctx, logger, info.FullMethod, err)
+		}
+		return resp, err
+	}
+}
+
+func statusError(ctx context.Context, logger *zap.Logger, method string, err error) error {
+	// storage errors can wrap the database's errors, so clients only get a message naming the records involved
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		logger.Debug("RPC failed", zap.String("method", method), zap.Error(err))
+		return status.Error(codes.NotFound, storage.ClientMessage(err))
+	case errors.Is(err, storage.ErrAlreadyExists):
+		logger.Info("RPC failed", zap.String("method", method), zap.Error(err))
+		return status.Error(codes.AlreadyExists, storage.ClientMessage(err))
+	case errors.Is(err, storage.ErrConflict):
+		logger.Info("RPC failed", zap.String("method", method), zap.Error(err))
+		return failedPrecondition(preconditionConflict, "", storage.ClientMessage(err))
+	case errors.Is(err, storage.ErrInvalidPageToken):
+		return invalidArgument("page_token", "is invalid")
+	}
+
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Internal && st.Code() != codes.Unknown {
+		return st.Err()
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	logger.Error("RPC failed", zap.String("method", method), zap.Error(err))
+	return status.Error(codes.Internal, "internal error")
+}
+
+// invalidArgument returns an InvalidArgument error for a request field, e.g. invalidArgument("user_id", "is required")
+func invalidArgument(field string, description string) error {
+	return withDetails(status.New(codes.InvalidArgument, field+" "+description), &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+}
+
+// failedPrecondition returns a FailedPrecondition error for the state of a resource, e.g. "clubs/<id>"
+func failedPrecondition(violationType string, subject string, description string) error {
+	return withDetails(status.New(codes.FailedPrecondition, description), &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{Type: violationType, Subject: subject, Description: description}},
+	})
+}
+
+func withDetails(st *status.Status, details protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
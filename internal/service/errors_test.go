@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+func Test_ErrorInterceptor(t *testing.T) {
+	interceptor := ErrorInterceptor(zap.NewNop())
+	info := &grpc.UnaryServerInfo{FullMethod: "/watchclub.v1.WatchClubService/GetUser"}
+	intercept := func(ctx context.Context, err error) *status.Status {
+		_, err = interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+			return nil, err
+		})
+		return status.Convert(err)
+	}
+	ctx := context.Background()
+
+	_, notFound := storage.NewMemoryStorage().GetClub(ctx, "movie-night")
+	st := intercept(ctx, fmt.Errorf("failed to get club: %w", notFound))
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "club not found: movie-night", st.Message())
+
+	// the database's errors aren't shown to the client
+	st = intercept(ctx, fmt.Errorf("failed to insert user: %w: %w", storage.ErrAlreadyExists, errors.New("UNIQUE constraint failed: users.email (2067)")))
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	assert.Equal(t, "already exists", st.Message())
+
+	st = intercept(ctx, fmt.Errorf("failed to insert pick: %w: %w", storage.ErrConflict, errors.New("FOREIGN KEY constraint failed (787)")))
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, "conflict", st.Message())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, preconditionConflict, st.Details()[0].(*errdetails.PreconditionFailure).Violations[0].Type)
+
+	st = intercept(ctx, fmt.Errorf("failed to list picks: %w", storage.ErrInvalidPageToken))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "page_token is invalid", st.Message())
+
+	// statuses from the service are passed through
+	st = intercept(ctx, invalidArgument("user_id", "is required"))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "user_id is required", st.Message())
+	require.Len(t, st.Details(), 1)
+	violation := st.Details()[0].(*errdetails.BadRequest).FieldViolations[0]
+	assert.Equal(t, "user_id", violation.Field)
+	assert.Equal(t, "is required", violation.Description)
+
+	// anything else is hidden from the client
+	st = intercept(ctx, fmt.Errorf("failed to query user: %w", errors.New("disk I/O error")))
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.NotContains(t, st.Message(), "disk")
+	assert.NotContains(t, intercept(ctx, status.Error(codes.Internal, "secret")).Message(), "secret")
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, codes.Canceled, intercept(canceled, fmt.Errorf("failed to query user: %w", context.Canceled)).Code())
+}
+
+func Test_ErrorDetails(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+
+	_, err := svc.GetUser(ctx, &v1.GetUserRequest{UserId: "nobody"})
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	club, err := svc.CreateClub(ctx, &v1.CreateClubRequest{Name: "Movie Night", StartDate: timestamppb.Now()})
+	require.NoError(t, err)
+
+	_, err = svc.StartClub(ctx, &v1.StartClubRequest{ClubId: club.Club.Id})
+	st := status.Convert(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 1)
+	violation := st.Details()[0].(*errdetails.PreconditionFailure).Violations[0]
+	assert.Equal(t, preconditionNoPicks, violation.Type)
+	assert.Equal(t, "clubs/"+club.Club.Id, violation.Subject)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// GetNotificationPreferences gets a user's notification preferences
+func (s *WatchClubService) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (*v1.GetNotificationPreferencesResponse, error) {
+	if req.UserId == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &v1.GetNotificationPreferencesResponse{
+		Preferences: s.notificationPreferences(ctx, req.UserId),
+	}, nil
+}
+
+// UpdateNotificationPreferences replaces a user's notification preferences
+func (s *WatchClubService) UpdateNotificationPreferences(ctx context.Context, req *v1.UpdateNotificationPreferencesRequest) (*v1.UpdateNotificationPreferencesResponse, error) {
+	prefs := req.Preferences
+	if prefs == nil {
+		return nil, invalidArgument("preferences", "is required")
+	}
+	if prefs.UserId == "" {
+		return nil, invalidArgument("preferences.user_id", "is required")
+	}
+	// unspecified means the default day
+	if prefs.DigestWeekday < v1.Weekday_WEEKDAY_UNSPECIFIED || prefs.DigestWeekday > v1.Weekday_WEEKDAY_SATURDAY {
+		return nil, invalidArgument("preferences.digest_weekday", "must be a day from WEEKDAY_SUNDAY to WEEKDAY_SATURDAY")
+	}
+	if slices.Contains(prefs.DisabledTypes, v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED) {
+		return nil, invalidArgument("preferences.disabled_types", "cannot contain NOTIFICATION_TYPE_UNSPECIFIED")
+	}
+	clubIDs := make(map[string]bool, len(prefs.Clubs))
+	for _, club := range prefs.Clubs {
+		if club.ClubId == "" {
+			return nil, invalidArgument("preferences.clubs.club_id", "is required")
+		}
+		if clubIDs[club.ClubId] {
+			return nil, invalidArgument("preferences.clubs", fmt.Sprintf("contains %s more than once", club.ClubId))
+		}
+		clubIDs[club.ClubId] = true
+		if slices.Contains(club.DisabledTypes, v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED) {
+			return nil, invalidArgument("preferences.clubs.disabled_types", "cannot contain NOTIFICATION_TYPE_UNSPECIFIED")
+		}
+	}
+
+	if _, err := s.storage.GetUser(ctx, prefs.UserId); err != nil {
+		return nil, err
+	}
+
+	// output only
+	prefs.LastDigestSentAt = s.notificationPreferences(ctx, prefs.UserId).LastDigestSentAt
+
+	prefs.UpdatedAt = timestamppb.Now()
+	if err := s.storage.PutNotificationPreferences(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return &v1.UpdateNotificationPreferencesResponse{Preferences: prefs}, nil
+}
+
+// notificationPreferences returns a user's saved preferences, or the defaults (everything enabled) if there are none
+func (s *WatchClubService) notificationPreferences(ctx context.Context, userID string) *v1.NotificationPreferences {
+	prefs, err := s.storage.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			s.logger.Error("Failed to get notification preferences, using the defaults", zap.String("userId", userID), zap.Error(err))
+		}
+		return &v1.NotificationPreferences{UserId: userID}
+	}
+	return prefs
+}
+
+// notificationEnabled reports whether a user wants notifications of type t about a club.
+// An empty clubID checks only the user-wide preferences.
+func notificationEnabled(prefs *v1.NotificationPreferences, clubID string, t v1.NotificationType) bool {
+	if prefs.UnsubscribedAll || slices.Contains(prefs.DisabledTypes, t) {
+		return false
+	}
+	for _, club := range prefs.Clubs {
+		if club.ClubId == clubID {
+			return !club.Muted && !slices.Contains(club.DisabledTypes, t)
+		}
+	}
+	return true
+}
+
+// applyUnsubscribe disables the notifications identified by an unsubscribe token
+func applyUnsubscribe(prefs *v1.NotificationPreferences, token *mail.UnsubscribeToken) {
+	if token.ClubID == "" {
+		if !slices.Contains(prefs.DisabledTypes, token.Type) {
+			prefs.DisabledTypes = append(prefs.DisabledTypes, token.Type)
+		}
+		return
+	}
+	for _, club := range prefs.Clubs {
+		if club.ClubId == token.ClubID {
+			if !slices.Contains(club.DisabledTypes, token.Type) {
+				club.DisabledTypes = append(club.DisabledTypes, token.Type)
+			}
+			return
+		}
+	}
+	prefs.Clubs = append(prefs.Clubs, &v1.ClubNotificationPreferences{
+		ClubId:        token.ClubID,
+		DisabledTypes: []v1.NotificationType{token.Type},
+	})
+}
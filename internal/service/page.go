@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// Page sizes of list RPCs, following https://google.aip.dev/158
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listPage returns the storage page for a list request's page_size and page_token
+func listPage(pageSize int32, pageToken string) (storage.Page, error) {
+	switch {
+	case pageSize < 0:
+		return storage.Page{}, invalidArgument("page_size", "must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	return storage.Page{Size: int(pageSize), Token: pageToken}, nil
+}
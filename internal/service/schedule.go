@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+)
+
+// MoveScheduledPick moves a scheduled pick to a new date, updating members' calendars
+func (s *WatchClubService) MoveScheduledPick(ctx context.Context, req *v1.MoveScheduledPickRequest) (*v1.MoveScheduledPickResponse, error) {
+	if req.ScheduledPickId == "" {
+		return nil, invalidArgument("scheduled_pick_id", "is required")
+	}
+	if req.StartDate == nil {
+		return nil, invalidArgument("start_date", "is required")
+	}
+
+	assignment, err := s.storage.GetScheduledPick(ctx, req.ScheduledPickId)
+	if err != nil {
+		return nil, err
+	}
+
+	club, err := s.storage.GetClub(ctx, assignment.ClubId)
+	if err != nil {
+		return nil, err
+	}
+
+	change := mail.ScheduleChange{
+		Title:        assignment.Pick.Title,
+		Year:         assignment.Pick.Year,
+		OldStartDate: assignment.StartDate.AsTime(),
+		NewStartDate: req.StartDate.AsTime(),
+	}
+
+	assignment.StartDate = req.StartDate
+	assignment.CalendarSequence++
+	if err := s.storage.UpdateScheduledPick(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to update scheduled pick: %w", err)
+	}
+	if err := s.touchClub(ctx, club); err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(assignment).(*v1.ScheduledPick)
+	go s.sendScheduleChangedEmails(context.Background(), club, ics.MethodRequest, []*v1.ScheduledPick{updated}, []mail.ScheduleChange{change})
+
+	return &v1.MoveScheduledPickResponse{Assignment: assignment}, nil
+}
+
+// DeleteScheduledPick removes a pick from a club's schedule, cancelling it in members' calendars
+func (s *WatchClubService) DeleteScheduledPick(ctx context.Context, req *v1.DeleteScheduledPickRequest) (*v1.DeleteScheduledPickResponse, error) {
+	if req.ScheduledPickId == "" {
+		return nil, invalidArgument("scheduled_pick_id", "is required")
+	}
+
+	assignment, err := s.storage.GetScheduledPick(ctx, req.ScheduledPickId)
+	if err != nil {
+		return nil, err
+	}
+
+	club, err := s.storage.GetClub(ctx, assignment.ClubId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.DeleteScheduledPick(ctx, assignment.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete scheduled pick: %w", err)
+	}
+	if err := s.touchClub(ctx, club); err != nil {
+		return nil, err
+	}
+
+	s.cancelScheduledPicks(club, []*v1.ScheduledPick{assignment})
+
+	return &v1.DeleteScheduledPickResponse{Success: true}, nil
+}
+
+// ResetClub discards a club's schedule (cancelling it in members' calendars) so that it can be started again
+func (s *WatchClubService) ResetClub(ctx context.Context, req *v1.ResetClubRequest) (*v1.ResetClubResponse, error) {
+	if req.ClubId == "" {
+		return nil, invalidArgument("club_id", "is required")
+	}
+
+	club, err := s.storage.GetClub(ctx, req.ClubId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !club.Started {
+		return nil, failedPrecondition(preconditionClubNotStarted, "clubs/"+club.Id, "club has not started")
+	}
+
+	// Mark club as not started, discarding its schedule in the same step so a failure can't leave half of it behind
+	club.Started = false
+	club.UpdatedAt = timestamppb.Now()
+	assignments, err := s.storage.ResetSchedule(ctx, club)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset schedule: %w", err)
+	}
+
+	// Only cancel the events once they're gone for good
+	s.cancelScheduledPicks(club, assignments)
+
+	return &v1.ResetClubResponse{Club: club}, nil
+}
+
+// touchClub saves a club with its updated_at set to now, so that calendar feeds know it changed
+func (s *WatchClubService) touchClub(ctx context.Context, club *v1.Club) error {
+	club.UpdatedAt = timestamppb.Now()
+	if err := s.storage.UpdateClub(ctx, club); err != nil {
+		return fmt.Errorf("failed to update club: %w", err)
+	}
+	return nil
+}
+
+// cancelScheduledPicks sends cancellations for scheduled picks that have been removed from a club's schedule
+func (s *WatchClubService) cancelScheduledPicks(club *v1.Club, assignments []*v1.ScheduledPick) {
+	if len(assignments) == 0 {
+		return
+	}
+
+	cancelled := make([]*v1.ScheduledPick, 0, len(assignments))
+	changes := make([]mail.ScheduleChange, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignment = proto.Clone(assignment).(*v1.ScheduledPick)
+		assignment.CalendarSequence++
+		cancelled = append(cancelled, assignment)
+		changes = append(changes, mail.ScheduleChange{
+			Title:        assignment.Pick.Title,
+			Year:         assignment.Pick.Year,
+			OldStartDate: assignment.StartDate.AsTime(),
+			Cancelled:    true,
+		})
+	}
+
+	// Use background context since this runs in a goroutine after the RPC returns
+	go s.sendScheduleChangedEmails(context.Background(), proto.Clone(club).(*v1.Club), ics.MethodCancel, cancelled, changes)
+}
+
+// sendScheduleChangedEmails sends each club member an iTIP message that updates or cancels the changed events
+func (s *WatchClubService) sendScheduleChangedEmails(ctx context.Context, club *v1.Club, method string, assignments []*v1.ScheduledPick, changes []mail.ScheduleChange) {
+	s.logger.Info("Sending schedule changed emails",
+		zap.String("clubId", club.Id),
+		zap.String("method", method),
+		zap.Int("changes", len(changes)))
+
+	userMap, err := s.scheduleUsers(ctx, []*v1.Club{club}, assignments)
+	if err != nil {
+		s.logger.Error("Failed to get users for email notification",
+			zap.String("clubId", club.Id),
+			zap.Error(err))
+		return
+	}
+
+	emailsSent := 0
+	for _, memberID := range club.MemberIds {
+		user, ok := userMap[memberID]
+		if !ok {
+			continue
+		}
+		if user.Email == "" {
+			continue
+		}
+
+		if !notificationEnabled(s.notificationPreferences(ctx, user.Id), club.Id, v1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_CHANGES) {
+			s.logger.Info("User has disabled schedule change emails, skipping",
+				zap.String("userId", user.Id),
+				zap.String("clubId", club.Id))
+			continue
+		}
+
+		// each attendee gets their own message, since iTIP messages are addressed to an attendee
+		calendar := newCalendar(method, club.Name+" - Schedule")
+		for _, event := range s.scheduleEvents(club, assignments, userMap, time.Now()) {
+			event.Organizer = &ics.Organizer{Name: club.Name, Email: s.calendarOrganizer}
+			event.Attendees = []*ics.Attendee{{Name: user.Name, Email: user.Email}}
+			if method == ics.MethodCancel {
+				event.Status = ics.StatusCancelled
+			}
+			calendar.Events = append(calendar.Events, event)
+		}
+		var msg *mail.Message
+		icsData, err := calendar.Marshal()
+		if err == nil {
+			msg, err = s.renderer.ScheduleChanged(mail.ScheduleChangedParams{
+				To:        user.Email,
+				UserID:    user.Id,
+				UserName:  user.Name,
+				ClubName:  club.Name,
+				ClubID:    club.Id,
+				BaseURL:   s.baseURL,
+				Changes:   changes,
+				ICSData:   icsData,
+				ICSMethod: method,
+			})
+		}
+		if err == nil {
+			err = s.mailSender.Send(ctx, msg)
+		}
+		if err != nil {
+			s.logger.Error("Failed to send schedule changed email",
+				zap.String("to", user.Email),
+				zap.Error(err))
+		} else {
+			emailsSent++
+		}
+	}
+
+	s.logger.Info("Finished sending schedule changed emails",
+		zap.String("clubId", club.Id),
+		zap.Int("emailsSent", emailsSent),
+		zap.Int("totalMembers", len(club.MemberIds)))
+}
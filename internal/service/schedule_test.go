@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// startTestClub creates a started club with two members and one pick each
+func startTestClub(t *testing.T, svc *WatchClubService) *v1.StartClubResponse {
+	ctx := context.Background()
+	store := svc.storage
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, store.CreateClub(ctx, &v1.Club{
+		Id:                       "club",
+		Name:                     "Movie Night",
+		MemberIds:                []string{"jo", "sam"},
+		StartDate:                timestamppb.New(time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)),
+		ScheduleIntervalQuantity: 1,
+		ScheduleIntervalUnit:     v1.ScheduleIntervalUnit_SCHEDULE_INTERVAL_UNIT_WEEKS,
+	}))
+	require.NoError(t, store.CreatePick(ctx, &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", Year: 1995}))
+	require.NoError(t, store.CreatePick(ctx, &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien", Year: 1979}))
+
+	resp, err := svc.StartClub(ctx, &v1.StartClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	for _, assignment := range resp.Assignments {
+		assert.NotEmpty(t, assignment.CalendarUid)
+		assert.Zero(t, assignment.CalendarSequence)
+	}
+	return resp
+}
+
+// waitForMessages waits until n messages have been sent with the given subject
+func waitForMessages(t *testing.T, sender *captureSender, subject string, n int) []string {
+	var attachments []string
+	require.Eventually(t, func() bool {
+		attachments = nil
+		for _, msg := range sender.sent() {
+			if msg.Subject == subject {
+				attachments = append(attachments, string(msg.Attachments[0].Content))
+			}
+		}
+		return len(attachments) == n
+	}, time.Second, 10*time.Millisecond)
+	return attachments
+}
+
+func Test_MoveScheduledPick(t *testing.T) {
+	ctx := context.Background()
+	svc, sender := newTestService(t)
+	started := startTestClub(t, svc)
+	assignment := started.Assignments[0]
+
+	newStart := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := svc.MoveScheduledPick(ctx, &v1.MoveScheduledPickRequest{ScheduledPickId: assignment.Id, StartDate: timestamppb.New(newStart)})
+	require.NoError(t, err)
+	assert.Equal(t, assignment.CalendarUid, resp.Assignment.CalendarUid)
+	assert.Equal(t, int32(1), resp.Assignment.CalendarSequence)
+
+	stored, err := svc.storage.GetScheduledPick(ctx, assignment.Id)
+	require.NoError(t, err)
+	assert.Equal(t, newStart, stored.StartDate.AsTime())
+	assert.Equal(t, int32(1), stored.CalendarSequence)
+
+	for _, ics := range waitForMessages(t, sender, "📅 Movie Night schedule update", 2) {
+		assert.Contains(t, ics, "METHOD:REQUEST\r\n")
+		assert.Contains(t, ics, "UID:"+assignment.CalendarUid+"\r\n")
+		assert.Contains(t, ics, "SEQUENCE:1\r\n")
+		assert.Contains(t, ics, "DTSTART;VALUE=DATE:20260401\r\n")
+		assert.Contains(t, ics, "ORGANIZER;CN=Movie Night:mailto:club@watchclub.example.com\r\n")
+		assert.Equal(t, 1, strings.Count(ics, "ATTENDEE"))
+		assert.NotContains(t, ics, "STATUS:CANCELLED")
+	}
+
+	_, err = svc.MoveScheduledPick(ctx, &v1.MoveScheduledPickRequest{ScheduledPickId: assignment.Id})
+	assert.Error(t, err)
+}
+
+func Test_ResetClub(t *testing.T) {
+	ctx := context.Background()
+	svc, sender := newTestService(t)
+	started := startTestClub(t, svc)
+
+	resp, err := svc.ResetClub(ctx, &v1.ResetClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	assert.False(t, resp.Club.Started)
+
+	assignments, err := svc.storage.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	for _, ics := range waitForMessages(t, sender, "📅 Movie Night schedule update", 2) {
+		assert.Contains(t, ics, "METHOD:CANCEL\r\n")
+		assert.Equal(t, 2, strings.Count(ics, "STATUS:CANCELLED\r\n"))
+		assert.Equal(t, 2, strings.Count(ics, "SEQUENCE:1\r\n"))
+		for _, assignment := range started.Assignments {
+			assert.Contains(t, ics, "UID:"+assignment.CalendarUid+"\r\n")
+		}
+	}
+
+	// the club can be started again
+	_, err = svc.StartClub(ctx, &v1.StartClubRequest{ClubId: "club"})
+	assert.NoError(t, err)
+}
+
+func Test_DeleteScheduledPick_RespectsPreferences(t *testing.T) {
+	ctx := context.Background()
+	svc, sender := newTestService(t)
+	started := startTestClub(t, svc)
+
+	require.NoError(t, svc.storage.PutNotificationPreferences(ctx, &v1.NotificationPreferences{
+		UserId:        "sam",
+		DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_CHANGES},
+	}))
+
+	_, err := svc.DeleteScheduledPick(ctx, &v1.DeleteScheduledPickRequest{ScheduledPickId: started.Assignments[0].Id})
+	require.NoError(t, err)
+
+	icsData := waitForMessages(t, sender, "📅 Movie Night schedule update", 1)
+	assert.Contains(t, icsData[0], "ATTENDEE;CN=Jo;RSVP=FALSE:mailto:jo@example.com\r\n")
+	assert.Contains(t, icsData[0], "STATUS:CANCELLED\r\n")
+}
+
+func Test_ListPages(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	started := startTestClub(t, svc)
+	require.NoError(t, svc.storage.CreateClub(ctx, &v1.Club{Id: "later", Name: "Later", MemberIds: []string{"jo"}, CreatedAt: timestamppb.Now()}))
+
+	clubs, err := svc.ListUserClubs(ctx, &v1.ListUserClubsRequest{UserId: "jo", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, clubs.Clubs, 1)
+	assert.Equal(t, "club", clubs.Clubs[0].Id)
+	clubs, err = svc.ListUserClubs(ctx, &v1.ListUserClubsRequest{UserId: "jo", PageSize: 1, PageToken: clubs.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, clubs.Clubs, 1)
+	assert.Equal(t, "later", clubs.Clubs[0].Id)
+	assert.Empty(t, clubs.NextPageToken)
+
+	notStarted := false
+	clubs, err = svc.ListUserClubs(ctx, &v1.ListUserClubsRequest{UserId: "jo", Started: &notStarted})
+	require.NoError(t, err)
+	require.Len(t, clubs.Clubs, 1)
+	assert.Equal(t, "later", clubs.Clubs[0].Id)
+
+	picks, err := svc.ListPicks(ctx, &v1.ListPicksRequest{ClubId: "club", UserId: "sam"})
+	require.NoError(t, err)
+	require.Len(t, picks.Picks, 1)
+	assert.Equal(t, "alien", picks.Picks[0].Id)
+
+	// only the pick moved into the future is upcoming
+	upcoming := started.Assignments[0]
+	_, err = svc.MoveScheduledPick(ctx, &v1.MoveScheduledPickRequest{ScheduledPickId: upcoming.Id, StartDate: timestamppb.New(time.Now().AddDate(0, 0, 1))})
+	require.NoError(t, err)
+	schedule, err := svc.GetScheduledPicks(ctx, &v1.GetScheduledPicksRequest{ClubId: "club", UpcomingOnly: true})
+	require.NoError(t, err)
+	require.Len(t, schedule.Assignments, 1)
+	assert.Equal(t, upcoming.Id, schedule.Assignments[0].Id)
+	schedule, err = svc.GetScheduledPicks(ctx, &v1.GetScheduledPicksRequest{ClubId: "club"})
+	require.NoError(t, err)
+	assert.Len(t, schedule.Assignments, 2)
+
+	// upcoming picks can be paged through, though the cutoff moves with the clock between requests
+	_, err = svc.MoveScheduledPick(ctx, &v1.MoveScheduledPickRequest{ScheduledPickId: started.Assignments[1].Id, StartDate: timestamppb.New(time.Now().AddDate(0, 0, 2))})
+	require.NoError(t, err)
+	schedule, err = svc.GetScheduledPicks(ctx, &v1.GetScheduledPicksRequest{ClubId: "club", UpcomingOnly: true, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, schedule.Assignments, 1)
+	require.NotEmpty(t, schedule.NextPageToken)
+	next, err := svc.GetScheduledPicks(ctx, &v1.GetScheduledPicksRequest{ClubId: "club", UpcomingOnly: true, PageSize: 1, PageToken: schedule.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, next.Assignments, 1)
+	assert.NotEqual(t, schedule.Assignments[0].Id, next.Assignments[0].Id)
+	assert.Empty(t, next.NextPageToken)
+	_, err = svc.GetScheduledPicks(ctx, &v1.GetScheduledPicksRequest{ClubId: "club", PageSize: 1, PageToken: schedule.NextPageToken})
+	assert.ErrorIs(t, err, storage.ErrInvalidPageToken)
+
+	_, err = svc.ListUserClubs(ctx, &v1.ListUserClubsRequest{UserId: "jo", PageSize: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.ListPicks(ctx, &v1.ListPicksRequest{ClubId: "club", PageToken: "garbage"})
+	assert.ErrorIs(t, err, storage.ErrInvalidPageToken)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -9,9 +11,11 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/ics"
 	"github.com/cartermckinnon/watchclub/internal/mail"
 	"github.com/cartermckinnon/watchclub/internal/storage"
 )
@@ -19,31 +23,61 @@ import (
 // WatchClubService implements the WatchClubServiceServer interface
 type WatchClubService struct {
 	v1.UnimplementedWatchClubServiceServer
-	storage    storage.Storage
-	mailSender mail.Sender
-	baseURL    string
-	logger     *zap.Logger
+	storage           storage.Storage
+	mailSender        mail.Sender
+	renderer          *mail.Renderer
+	unsubscribe       *mail.UnsubscribeSigner
+	baseURL           string
+	calendarOrganizer string
+	deletedRetention  time.Duration
+	logger            *zap.Logger
+}
+
+// Config holds the dependencies and settings of the service
+type Config struct {
+	Storage     storage.Storage
+	MailSender  mail.Sender
+	Renderer    *mail.Renderer
+	Unsubscribe *mail.UnsubscribeSigner
+
+	// BaseURL is the base URL of the UI, used to generate links
+	BaseURL string
+
+	// CalendarOrganizer is the email address used as the organizer of calendar invites
+	CalendarOrganizer string
+
+	// DeletedRetention is how long deleted clubs can be restored before they're purged (default: 30 days)
+	DeletedRetention time.Duration
+
+	Logger *zap.Logger
 }
 
 // New creates a new WatchClubService
-func New(store storage.Storage, mailSender mail.Sender, baseURL string, logger *zap.Logger) *WatchClubService {
+func New(config Config) *WatchClubService {
+	if config.DeletedRetention <= 0 {
+		config.DeletedRetention = defaultDeletedRetention
+	}
 	return &WatchClubService{
-		storage:    store,
-		mailSender: mailSender,
-		baseURL:    baseURL,
-		logger:     logger,
+		storage:           config.Storage,
+		mailSender:        config.MailSender,
+		renderer:          config.Renderer,
+		unsubscribe:       config.Unsubscribe,
+		baseURL:           config.BaseURL,
+		calendarOrganizer: config.CalendarOrganizer,
+		deletedRetention:  config.DeletedRetention,
+		logger:            config.Logger,
 	}
 }
 
 // GetUser gets a user by ID
 func (s *WatchClubService) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.GetUserResponse, error) {
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "is required")
 	}
 
 	user, err := s.storage.GetUser(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		return nil, err
 	}
 
 	return &v1.GetUserResponse{User: user}, nil
@@ -52,10 +86,10 @@ func (s *WatchClubService) GetUser(ctx context.Context, req *v1.GetUserRequest)
 // CreateUser creates a new user
 func (s *WatchClubService) CreateUser(ctx context.Context, req *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
 	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
+		return nil, invalidArgument("name", "is required")
 	}
 	if req.Email == "" {
-		return nil, status.Error(codes.InvalidArgument, "email is required")
+		return nil, invalidArgument("email", "is required")
 	}
 
 	// Check if email already exists
@@ -63,6 +97,9 @@ func (s *WatchClubService) CreateUser(ctx context.Context, req *v1.CreateUserReq
 	if err == nil && existingUser != nil {
 		return nil, status.Error(codes.AlreadyExists, "email already registered")
 	}
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
 
 	user := &v1.User{
 		Id:        uuid.New().String(),
@@ -72,7 +109,7 @@ func (s *WatchClubService) CreateUser(ctx context.Context, req *v1.CreateUserReq
 	}
 
 	if err := s.storage.CreateUser(ctx, user); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
 	return &v1.CreateUserResponse{User: user}, nil
@@ -81,16 +118,16 @@ func (s *WatchClubService) CreateUser(ctx context.Context, req *v1.CreateUserReq
 // CreateClub creates a new movie club
 func (s *WatchClubService) CreateClub(ctx context.Context, req *v1.CreateClubRequest) (*v1.CreateClubResponse, error) {
 	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
+		return nil, invalidArgument("name", "is required")
 	}
 	if req.StartDate == nil {
-		return nil, status.Error(codes.InvalidArgument, "start_date is required")
+		return nil, invalidArgument("start_date", "is required")
 	}
 
 	// Validate max_picks_per_member
 	maxPicks := req.MaxPicksPerMember
 	if maxPicks < 0 {
-		return nil, status.Error(codes.InvalidArgument, "max_picks_per_member cannot be negative")
+		return nil, invalidArgument("max_picks_per_member", "cannot be negative")
 	}
 	// Default to 1 if not specified (0 means unlimited for backward compatibility)
 	if maxPicks == 0 {
@@ -108,20 +145,22 @@ func (s *WatchClubService) CreateClub(ctx context.Context, req *v1.CreateClubReq
 		scheduleUnit = v1.ScheduleIntervalUnit_SCHEDULE_INTERVAL_UNIT_WEEKS // Default to weeks
 	}
 
+	now := timestamppb.Now()
 	club := &v1.Club{
 		Id:                       uuid.New().String(),
 		Name:                     req.Name,
 		MemberIds:                []string{},
 		StartDate:                req.StartDate,
 		Started:                  false,
-		CreatedAt:                timestamppb.Now(),
+		CreatedAt:                now,
+		UpdatedAt:                now,
 		MaxPicksPerMember:        maxPicks,
 		ScheduleIntervalQuantity: scheduleQty,
 		ScheduleIntervalUnit:     scheduleUnit,
 	}
 
 	if err := s.storage.CreateClub(ctx, club); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create club: %v", err)
+		return nil, fmt.Errorf("failed to create club: %w", err)
 	}
 
 	return &v1.CreateClubResponse{Club: club}, nil
@@ -130,21 +169,21 @@ func (s *WatchClubService) CreateClub(ctx context.Context, req *v1.CreateClubReq
 // JoinClub adds a user to a club
 func (s *WatchClubService) JoinClub(ctx context.Context, req *v1.JoinClubRequest) (*v1.JoinClubResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "is required")
 	}
 
 	// Get the club
 	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
 	// Verify user exists
 	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
-		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		return nil, err
 	}
 
 	// Check if user is already a member
@@ -156,13 +195,11 @@ func (s *WatchClubService) JoinClub(ctx context.Context, req *v1.JoinClubRequest
 
 	// Add user to club
 	club.MemberIds = append(club.MemberIds, req.UserId)
+	club.UpdatedAt = timestamppb.Now()
 
 	// Update club in storage
-	if err := s.storage.DeleteClub(ctx, club.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update club: %v", err)
-	}
-	if err := s.storage.CreateClub(ctx, club); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update club: %v", err)
+	if err := s.storage.UpdateClub(ctx, club); err != nil {
+		return nil, fmt.Errorf("failed to update club: %w", err)
 	}
 
 	return &v1.JoinClubResponse{Club: club}, nil
@@ -171,49 +208,33 @@ func (s *WatchClubService) JoinClub(ctx context.Context, req *v1.JoinClubRequest
 // AddPick adds a pick to a club
 func (s *WatchClubService) AddPick(ctx context.Context, req *v1.AddPickRequest) (*v1.AddPickResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "is required")
 	}
 	if req.Title == "" {
-		return nil, status.Error(codes.InvalidArgument, "title is required")
+		return nil, invalidArgument("title", "is required")
 	}
 
 	// Get club and verify it exists
 	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
 	// Check if club has already started
 	if club.Started {
-		return nil, status.Error(codes.FailedPrecondition, "cannot add picks after club has started")
+		return nil, failedPrecondition(preconditionClubStarted, "clubs/"+club.Id, "cannot add picks after club has started")
 	}
 
 	// Verify user exists
 	if _, err := s.storage.GetUser(ctx, req.UserId); err != nil {
-		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		return nil, err
 	}
 
-	// Check if user has reached max picks (0 means unlimited)
-	if club.MaxPicksPerMember > 0 {
-		existingPicks, err := s.storage.ListPicks(ctx, req.ClubId)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list picks: %v", err)
-		}
-
-		userPickCount := 0
-		for _, pick := range existingPicks {
-			if pick.UserId == req.UserId {
-				userPickCount++
-			}
-		}
-
-		if userPickCount >= int(club.MaxPicksPerMember) {
-			return nil, status.Errorf(codes.FailedPrecondition,
-				"user has already added maximum number of picks (%d)", club.MaxPicksPerMember)
-		}
+	if err := s.checkPickLimit(ctx, club, req.UserId); err != nil {
+		return nil, err
 	}
 
 	pick := &v1.Pick{
@@ -228,25 +249,51 @@ func (s *WatchClubService) AddPick(ctx context.Context, req *v1.AddPickRequest)
 	}
 
 	if err := s.storage.CreatePick(ctx, pick); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create pick: %v", err)
+		return nil, fmt.Errorf("failed to create pick: %w", err)
 	}
 
 	return &v1.AddPickResponse{Pick: pick}, nil
 }
 
-// DeletePick removes a pick from a club (only allowed before club starts)
+// checkPickLimit checks that a user can add another pick to a club
+func (s *WatchClubService) checkPickLimit(ctx context.Context, club *v1.Club, userID string) error {
+	// 0 means unlimited
+	if club.MaxPicksPerMember <= 0 {
+		return nil
+	}
+
+	existingPicks, err := s.storage.ListPicks(ctx, club.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list picks: %w", err)
+	}
+
+	userPickCount := 0
+	for _, pick := range existingPicks {
+		if pick.UserId == userID {
+			userPickCount++
+		}
+	}
+
+	if userPickCount >= int(club.MaxPicksPerMember) {
+		return failedPrecondition(preconditionPickLimit, "clubs/"+club.Id,
+			fmt.Sprintf("user has already added maximum number of picks (%d)", club.MaxPicksPerMember))
+	}
+	return nil
+}
+
+// DeletePick removes a pick from a club (only allowed before club starts), which can be restored with RestorePick until it's purged
 func (s *WatchClubService) DeletePick(ctx context.Context, req *v1.DeletePickRequest) (*v1.DeletePickResponse, error) {
 	if req.PickId == "" {
-		return nil, status.Error(codes.InvalidArgument, "pick_id is required")
+		return nil, invalidArgument("pick_id", "is required")
 	}
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "is required")
 	}
 
 	// Get the pick to verify ownership and club status
 	pick, err := s.storage.GetPick(ctx, req.PickId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "pick not found: %v", err)
+		return nil, err
 	}
 
 	// Verify user owns this pick
@@ -257,16 +304,16 @@ func (s *WatchClubService) DeletePick(ctx context.Context, req *v1.DeletePickReq
 	// Verify club hasn't started
 	club, err := s.storage.GetClub(ctx, pick.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
 	if club.Started {
-		return nil, status.Error(codes.FailedPrecondition, "cannot delete picks after club has started")
+		return nil, failedPrecondition(preconditionClubStarted, "clubs/"+club.Id, "cannot delete picks after club has started")
 	}
 
 	// Delete the pick
 	if err := s.storage.DeletePick(ctx, req.PickId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete pick: %v", err)
+		return nil, fmt.Errorf("failed to delete pick: %w", err)
 	}
 
 	return &v1.DeletePickResponse{Success: true}, nil
@@ -275,28 +322,23 @@ func (s *WatchClubService) DeletePick(ctx context.Context, req *v1.DeletePickReq
 // GetClub gets details about a club including members and their picks
 func (s *WatchClubService) GetClub(ctx context.Context, req *v1.GetClubRequest) (*v1.GetClubResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 
 	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
-	// Get all members
-	members := make([]*v1.User, 0, len(club.MemberIds))
-	for _, memberID := range club.MemberIds {
-		user, err := s.storage.GetUser(ctx, memberID)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user %s: %v", memberID, err)
-		}
-		members = append(members, user)
+	members, err := s.storage.GetUsers(ctx, club.MemberIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
 	}
 
 	// Get all picks
 	picks, err := s.storage.ListPicks(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get picks: %v", err)
+		return nil, fmt.Errorf("failed to get picks: %w", err)
 	}
 
 	return &v1.GetClubResponse{
@@ -309,26 +351,26 @@ func (s *WatchClubService) GetClub(ctx context.Context, req *v1.GetClubRequest)
 // StartClub shuffles all picks and generates the weekly viewing schedule
 func (s *WatchClubService) StartClub(ctx context.Context, req *v1.StartClubRequest) (*v1.StartClubResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 
 	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
 	if club.Started {
-		return nil, status.Error(codes.FailedPrecondition, "club already started")
+		return nil, failedPrecondition(preconditionClubStarted, "clubs/"+club.Id, "club already started")
 	}
 
 	// Get all picks
 	picks, err := s.storage.ListPicks(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get picks: %v", err)
+		return nil, fmt.Errorf("failed to get picks: %w", err)
 	}
 
 	if len(picks) == 0 {
-		return nil, status.Error(codes.FailedPrecondition, "no picks to shuffle")
+		return nil, failedPrecondition(preconditionNoPicks, "clubs/"+club.Id, "no picks to shuffle")
 	}
 
 	// Shuffle the picks
@@ -348,16 +390,18 @@ func (s *WatchClubService) StartClub(ctx context.Context, req *v1.StartClubReque
 
 	for i, pick := range shuffled {
 		periodStart := startDate.Add(intervalDuration * time.Duration(i))
+		id := uuid.New().String()
 		assignment := &v1.ScheduledPick{
-			Id:             uuid.New().String(),
+			Id:             id,
 			ClubId:         req.ClubId,
 			SequenceNumber: int32(i + 1),
 			StartDate:      timestamppb.New(periodStart),
 			Pick:           pick,
+			CalendarUid:    id + "@watchclub",
 		}
 
 		if err := s.storage.CreateScheduledPick(ctx, assignment); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create scheduled pick: %v", err)
+			return nil, fmt.Errorf("failed to create scheduled pick: %w", err)
 		}
 
 		assignments = append(assignments, assignment)
@@ -365,11 +409,9 @@ func (s *WatchClubService) StartClub(ctx context.Context, req *v1.StartClubReque
 
 	// Mark club as started
 	club.Started = true
-	if err := s.storage.DeleteClub(ctx, club.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update club: %v", err)
-	}
-	if err := s.storage.CreateClub(ctx, club); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update club: %v", err)
+	club.UpdatedAt = timestamppb.Now()
+	if err := s.storage.UpdateClub(ctx, club); err != nil {
+		return nil, fmt.Errorf("failed to update club: %w", err)
 	}
 
 	// Send notification emails to all members with calendar attachment
@@ -389,28 +431,35 @@ func (s *WatchClubService) sendClubStartedEmails(ctx context.Context, club *v1.C
 		zap.String("clubName", club.Name),
 		zap.Int("memberCount", len(club.MemberIds)))
 
-	// Get all users for the club
-	userMap := make(map[string]*v1.User)
-	for _, memberID := range club.MemberIds {
-		user, err := s.storage.GetUser(ctx, memberID)
-		if err != nil {
-			s.logger.Warn("Failed to get user for email notification",
-				zap.String("userId", memberID),
-				zap.Error(err))
-			continue
-		}
-		userMap[user.Id] = user
+	userMap, err := s.scheduleUsers(ctx, []*v1.Club{club}, assignments)
+	if err != nil {
+		s.logger.Error("Failed to get users for email notification",
+			zap.String("clubId", club.Id),
+			zap.Error(err))
+		return
 	}
 
 	// Generate ICS calendar data
-	icsData := generateICSCalendar(club, assignments, userMap, s.baseURL)
+	calendar := newCalendar(ics.MethodPublish, club.Name+" - Schedule")
+	calendar.Events = s.scheduleEvents(club, assignments, userMap, clubModified(club))
+	icsData, err := calendar.Marshal()
+	if err != nil {
+		s.logger.Error("Failed to generate ICS calendar data",
+			zap.String("clubId", club.Id),
+			zap.Error(err))
+		return
+	}
 	s.logger.Debug("Generated ICS calendar data",
 		zap.String("clubId", club.Id),
 		zap.Int("icsSize", len(icsData)))
 
 	// Send email to each member
 	emailsSent := 0
-	for _, user := range userMap {
+	for _, memberID := range club.MemberIds {
+		user, ok := userMap[memberID]
+		if !ok {
+			continue
+		}
 		if user.Email == "" {
 			s.logger.Warn("User has no email address, skipping",
 				zap.String("userId", user.Id),
@@ -418,18 +467,29 @@ func (s *WatchClubService) sendClubStartedEmails(ctx context.Context, club *v1.C
 			continue
 		}
 
+		if !notificationEnabled(s.notificationPreferences(ctx, user.Id), club.Id, v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED) {
+			s.logger.Info("User has disabled club started emails, skipping",
+				zap.String("userId", user.Id),
+				zap.String("clubId", club.Id))
+			continue
+		}
+
 		s.logger.Info("Sending club started email",
 			zap.String("to", user.Email),
 			zap.String("userName", user.Name))
 
-		err := s.mailSender.SendClubStarted(
-			user.Email,
-			user.Name,
-			club.Name,
-			club.Id,
-			s.baseURL,
-			[]byte(icsData),
-		)
+		msg, err := s.renderer.ClubStarted(mail.ClubStartedParams{
+			To:       user.Email,
+			UserID:   user.Id,
+			UserName: user.Name,
+			ClubName: club.Name,
+			ClubID:   club.Id,
+			BaseURL:  s.baseURL,
+			ICSData:  icsData,
+		})
+		if err == nil {
+			err = s.mailSender.Send(ctx, msg)
+		}
 		if err != nil {
 			s.logger.Error("Failed to send club started email",
 				zap.String("to", user.Email),
@@ -442,7 +502,7 @@ func (s *WatchClubService) sendClubStartedEmails(ctx context.Context, club *v1.C
 	s.logger.Info("Finished sending club started emails",
 		zap.String("clubId", club.Id),
 		zap.Int("emailsSent", emailsSent),
-		zap.Int("totalMembers", len(userMap)))
+		zap.Int("totalMembers", len(club.MemberIds)))
 }
 
 // calculateIntervalDuration converts schedule settings to a time.Duration
@@ -461,31 +521,42 @@ func calculateIntervalDuration(quantity int32, unit v1.ScheduleIntervalUnit) tim
 	}
 }
 
-// GetScheduledPicks gets the schedule for a club
+// GetScheduledPicks gets a page of the schedule for a club, in order
 func (s *WatchClubService) GetScheduledPicks(ctx context.Context, req *v1.GetScheduledPicksRequest) (*v1.GetScheduledPicksResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
+	}
+	page, err := listPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
 	// Verify club exists
-	if _, err := s.storage.GetClub(ctx, req.ClubId); err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+	club, err := s.storage.GetClub(ctx, req.ClubId)
+	if err != nil {
+		return nil, err
 	}
 
-	assignments, err := s.storage.ListScheduledPicks(ctx, req.ClubId)
+	query := storage.ScheduledPickQuery{ClubID: req.ClubId, Page: page}
+	if req.UpcomingOnly {
+		// a pick's period ends one interval after it starts
+		query.StartingFrom = time.Now().Add(-calculateIntervalDuration(club.ScheduleIntervalQuantity, club.ScheduleIntervalUnit))
+	}
+	assignments, next, err := s.storage.QueryScheduledPicks(ctx, query)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scheduled picks: %v", err)
+		return nil, fmt.Errorf("failed to get scheduled picks: %w", err)
 	}
 
 	return &v1.GetScheduledPicksResponse{
-		Assignments: assignments,
+		Assignments:   assignments,
+		NextPageToken: next,
 	}, nil
 }
 
 // SendLoginEmail sends an account login email
 func (s *WatchClubService) SendLoginEmail(ctx context.Context, req *v1.SendLoginEmailRequest) (*v1.SendLoginEmailResponse, error) {
 	if req.Email == "" {
-		return nil, status.Error(codes.InvalidArgument, "email is required")
+		return nil, invalidArgument("email", "is required")
 	}
 
 	// response is always the same
@@ -495,12 +566,24 @@ func (s *WatchClubService) SendLoginEmail(ctx context.Context, req *v1.SendLogin
 	}
 
 	user, err := s.storage.GetUserByEmail(ctx, req.Email)
-	if err != nil {
+	if errors.Is(err, storage.ErrNotFound) {
 		return &response, nil
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.mailSender.SendLogin(user.Email, user.Name, user.Id, s.baseURL); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to send login email: %v", err)
+	msg, err := s.renderer.Login(mail.LoginParams{
+		To:       user.Email,
+		UserName: user.Name,
+		UserID:   user.Id,
+		BaseURL:  s.baseURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render login email: %w", err)
+	}
+	if err := s.mailSender.Send(ctx, msg); err != nil {
+		return nil, fmt.Errorf("failed to send login email: %w", err)
 	}
 
 	return &response, nil
@@ -509,93 +592,123 @@ func (s *WatchClubService) SendLoginEmail(ctx context.Context, req *v1.SendLogin
 // GetClubCalendar generates an ICS calendar file for a club's schedule
 func (s *WatchClubService) GetClubCalendar(ctx context.Context, req *v1.GetClubCalendarRequest) (*v1.GetClubCalendarResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 
 	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "club not found: %v", err)
+		return nil, err
 	}
 
 	if !club.Started {
-		return nil, status.Error(codes.FailedPrecondition, "club must be started to generate calendar")
+		return nil, failedPrecondition(preconditionClubNotStarted, "clubs/"+club.Id, "club must be started to generate calendar")
 	}
 
 	assignments, err := s.storage.ListScheduledPicks(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list scheduled picks: %v", err)
+		return nil, fmt.Errorf("failed to list scheduled picks: %w", err)
 	}
 
-	// Get members to include picker names
-	users, err := s.storage.ListUsers(ctx)
+	// Get pickers to include their names
+	userMap, err := s.scheduleUsers(ctx, []*v1.Club{club}, assignments)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
-	}
-	userMap := make(map[string]*v1.User)
-	for _, user := range users {
-		userMap[user.Id] = user
+		return nil, err
 	}
 
-	icsData := generateICSCalendar(club, assignments, userMap, s.baseURL)
+	calendar := newCalendar(ics.MethodPublish, club.Name+" - Schedule")
+	calendar.Events = s.scheduleEvents(club, assignments, userMap, clubModified(club))
+	icsData, err := calendar.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate calendar: %w", err)
+	}
 
 	return &v1.GetClubCalendarResponse{
-		IcsData: icsData,
+		IcsData: string(icsData),
 	}, nil
 }
 
-// ListUserClubs lists all clubs a user is a member of
+// ListUserClubs lists a page of the clubs a user is a member of, oldest first
 func (s *WatchClubService) ListUserClubs(ctx context.Context, req *v1.ListUserClubsRequest) (*v1.ListUserClubsResponse, error) {
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "is required")
+	}
+	page, err := listPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	clubs, err := s.storage.ListClubsForUser(ctx, req.UserId)
+	clubs, next, err := s.storage.QueryClubs(ctx, storage.ClubQuery{MemberID: req.UserId, Started: req.Started, Page: page})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list clubs for user: %v", err)
+		return nil, fmt.Errorf("failed to list clubs for user: %w", err)
 	}
 
 	return &v1.ListUserClubsResponse{
-		Clubs: clubs,
+		Clubs:         clubs,
+		NextPageToken: next,
 	}, nil
 }
 
-// DeleteClub deletes a club and all associated data
+// ListPicks lists a page of a club's picks, oldest first
+func (s *WatchClubService) ListPicks(ctx context.Context, req *v1.ListPicksRequest) (*v1.ListPicksResponse, error) {
+	if req.ClubId == "" {
+		return nil, invalidArgument("club_id", "is required")
+	}
+	page, err := listPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify club exists
+	if _, err := s.storage.GetClub(ctx, req.ClubId); err != nil {
+		return nil, err
+	}
+
+	picks, next, err := s.storage.QueryPicks(ctx, storage.PickQuery{ClubID: req.ClubId, UserID: req.UserId, Page: page})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list picks: %w", err)
+	}
+
+	return &v1.ListPicksResponse{
+		Picks:         picks,
+		NextPageToken: next,
+	}, nil
+}
+
+// DeleteClub deletes a club and all associated data, which can be restored with RestoreClub until it's purged
 func (s *WatchClubService) DeleteClub(ctx context.Context, req *v1.DeleteClubRequest) (*v1.DeleteClubResponse, error) {
 	if req.ClubId == "" {
-		return nil, status.Error(codes.InvalidArgument, "club_id is required")
+		return nil, invalidArgument("club_id", "is required")
 	}
 
-	// Delete all picks for this club
-	clubReq := &v1.GetClubRequest{ClubId: req.ClubId}
-	clubResp, err := s.GetClub(ctx, clubReq)
+	club, err := s.storage.GetClub(ctx, req.ClubId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get club: %v", err)
+		return nil, err
 	}
 
-	for _, pick := range clubResp.Picks {
-		if err := s.storage.DeletePick(ctx, pick.Id); err != nil {
-			s.logger.Warn("Failed to delete pick during club deletion",
-				zap.String("pickId", pick.Id),
-				zap.Error(err))
-		}
+	// Read the schedule first so that it can be removed from members' calendars
+	assignments, err := s.storage.ListScheduledPicks(ctx, req.ClubId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled picks: %w", err)
 	}
 
-	// Delete all scheduled picks for this club
-	scheduledPicksReq := &v1.GetScheduledPicksRequest{ClubId: req.ClubId}
-	scheduledPicksResp, err := s.GetScheduledPicks(ctx, scheduledPicksReq)
-	if err == nil {
-		for _, assignment := range scheduledPicksResp.Assignments {
-			if err := s.storage.DeleteScheduledPick(ctx, assignment.Id); err != nil {
-				s.logger.Warn("Failed to delete scheduled pick during club deletion",
-					zap.String("scheduledPickId", assignment.Id),
-					zap.Error(err))
+	// The cancellations bump each event's sequence, which is saved so that restoring the club can supersede them
+	if club.Started {
+		for _, assignment := range assignments {
+			cancelled := proto.Clone(assignment).(*v1.ScheduledPick)
+			cancelled.CalendarSequence++
+			if err := s.storage.UpdateScheduledPick(ctx, cancelled); err != nil {
+				return nil, fmt.Errorf("failed to update scheduled pick: %w", err)
 			}
 		}
 	}
 
-	// Delete the club itself
+	// Hides the club's picks and schedule along with it
 	if err := s.storage.DeleteClub(ctx, req.ClubId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete club: %v", err)
+		return nil, fmt.Errorf("failed to delete club: %w", err)
+	}
+
+	if club.Started {
+		s.cancelScheduledPicks(club, assignments)
 	}
 
 	s.logger.Info("Club deleted",
@@ -605,109 +718,3 @@ func (s *WatchClubService) DeleteClub(ctx context.Context, req *v1.DeleteClubReq
 		Success: true,
 	}, nil
 }
-
-// generateICSCalendar creates an ICS calendar file from scheduled picks
-func generateICSCalendar(club *v1.Club, assignments []*v1.ScheduledPick, userMap map[string]*v1.User, baseURL string) string {
-	var ics string
-
-	// Calendar header
-	ics += "BEGIN:VCALENDAR\r\n"
-	ics += "VERSION:2.0\r\n"
-	ics += "PRODID:-//WatchClub//Schedule//EN\r\n"
-	ics += "CALSCALE:GREGORIAN\r\n"
-	ics += "METHOD:PUBLISH\r\n"
-	ics += "X-WR-CALNAME:" + escapeICSText(club.Name) + " - Schedule\r\n"
-	ics += "X-WR-TIMEZONE:UTC\r\n"
-
-	// Calculate interval duration
-	intervalDuration := calculateIntervalDuration(club.ScheduleIntervalQuantity, club.ScheduleIntervalUnit)
-
-	// Add events for each assignment
-	for _, assignment := range assignments {
-		pick := assignment.Pick
-		startDate := assignment.StartDate.AsTime()
-		endDate := startDate.Add(intervalDuration)
-
-		// Get picker name
-		pickerName := "Unknown"
-		if user, ok := userMap[pick.UserId]; ok {
-			pickerName = user.Name
-		}
-
-		// Build description
-		description := "Picked by " + pickerName
-		if pick.Notes != "" {
-			description += "\\n\\nNotes: " + escapeICSText(pick.Notes)
-		}
-		description += "\\n\\nView details: " + baseURL + "/#/club/" + club.Id + "/pick/" + pick.Id
-
-		// Event
-		ics += "BEGIN:VEVENT\r\n"
-		ics += "UID:" + pick.Id + "@watchclub\r\n"
-		ics += "DTSTAMP:" + formatICSDateTime(time.Now()) + "\r\n"
-		ics += "DTSTART;VALUE=DATE:" + formatICSDate(startDate) + "\r\n"
-		ics += "DTEND;VALUE=DATE:" + formatICSDate(endDate) + "\r\n"
-		ics += "SUMMARY:" + escapeICSText(pick.Title)
-		if pick.Year > 0 {
-			ics += " (" + string(rune(pick.Year/1000+'0')) + string(rune((pick.Year/100)%10+'0')) + string(rune((pick.Year/10)%10+'0')) + string(rune(pick.Year%10+'0')) + ")"
-		}
-		ics += "\r\n"
-		ics += "DESCRIPTION:" + description + "\r\n"
-		if pick.Link != "" {
-			ics += "LOCATION:" + escapeICSText(pick.Link) + "\r\n"
-			ics += "URL:" + escapeICSText(pick.Link) + "\r\n"
-		}
-		ics += "TRANSP:TRANSPARENT\r\n"
-		ics += "END:VEVENT\r\n"
-	}
-
-	ics += "END:VCALENDAR\r\n"
-
-	return ics
-}
-
-// formatICSDate formats a time as YYYYMMDD for ICS DATE format
-func formatICSDate(t time.Time) string {
-	return t.Format("20060102")
-}
-
-// formatICSDateTime formats a time as YYYYMMDDTHHMMSSZ for ICS DATETIME format
-func formatICSDateTime(t time.Time) string {
-	return t.UTC().Format("20060102T150405Z")
-}
-
-// escapeICSText escapes special characters in ICS text fields
-func escapeICSText(s string) string {
-	// Replace special characters
-	s = replaceAll(s, "\\", "\\\\")
-	s = replaceAll(s, ",", "\\,")
-	s = replaceAll(s, ";", "\\;")
-	s = replaceAll(s, "\n", "\\n")
-	s = replaceAll(s, "\r", "")
-	return s
-}
-
-// replaceAll is a simple string replace helper
-func replaceAll(s, old, new string) string {
-	result := ""
-	for {
-		i := indexOf(s, old)
-		if i == -1 {
-			result += s
-			break
-		}
-		result += s[:i] + new
-		s = s[i+len(old):]
-	}
-	return result
-}
-
-// indexOf finds the first occurrence of a substring
-func indexOf(s, substr string) int {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return i
-		}
-	}
-	return -1
-}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+// captureSender records sent messages
+type captureSender struct {
+	mu       sync.Mutex
+	messages []*mail.Message
+}
+
+func (c *captureSender) Send(ctx context.Context, msg *mail.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, msg)
+	return nil
+}
+
+// sent returns the messages sent so far
+func (c *captureSender) sent() []*mail.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*mail.Message(nil), c.messages...)
+}
+
+func newTestService(t *testing.T) (*WatchClubService, *captureSender) {
+	signer := mail.NewUnsubscribeSigner([]byte("secret"))
+	renderer, err := mail.NewRenderer("", signer)
+	require.NoError(t, err)
+	sender := &captureSender{}
+	return New(Config{
+		Storage:           storage.NewMemoryStorage(),
+		MailSender:        sender,
+		Renderer:          renderer,
+		Unsubscribe:       signer,
+		BaseURL:           "https://watchclub.example.com/",
+		CalendarOrganizer: "club@watchclub.example.com",
+		Logger:            zap.NewNop(),
+	}), sender
+}
+
+// lookupCounter counts the storage lookups that fetch users one at a time, or all of them
+type lookupCounter struct {
+	storage.Storage
+	mu      sync.Mutex
+	lookups map[string]int
+}
+
+func (l *lookupCounter) count(method string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lookups[method]++
+}
+
+func (l *lookupCounter) GetUser(ctx context.Context, id string) (*v1.User, error) {
+	l.count("GetUser")
+	return l.Storage.GetUser(ctx, id)
+}
+
+func (l *lookupCounter) ListUsers(ctx context.Context) ([]*v1.User, error) {
+	l.count("ListUsers")
+	return l.Storage.ListUsers(ctx)
+}
+
+func Test_BatchLookups(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	counter := &lookupCounter{Storage: svc.storage, lookups: make(map[string]int)}
+	svc.storage = counter
+	startTestClub(t, svc)
+	require.NoError(t, svc.storage.CreateUser(ctx, &v1.User{Id: "al", Name: "Al", Email: "al@example.com"}))
+
+	club, err := svc.GetClub(ctx, &v1.GetClubRequest{ClubId: "club"})
+	require.NoError(t, err)
+	require.Len(t, club.Members, 2)
+	assert.Equal(t, "jo", club.Members[0].Id)
+	assert.Equal(t, "sam", club.Members[1].Id)
+
+	calendar, err := svc.GetClubCalendar(ctx, &v1.GetClubCalendarRequest{ClubId: "club"})
+	require.NoError(t, err)
+	assert.Contains(t, calendar.IcsData, "Picked by Jo")
+	assert.Contains(t, calendar.IcsData, "Picked by Sam")
+
+	// members and pickers are fetched together, and the calendar doesn't load every user
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	assert.Zero(t, counter.lookups["GetUser"])
+	assert.Zero(t, counter.lookups["ListUsers"])
+}
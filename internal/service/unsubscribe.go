@@ -0,0 +1,116 @@
+package service
+
+import (
+	"html/template"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>Unsubscribe - WatchClub</title>
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; max-width: 480px; margin: 48px auto; padding: 0 20px; color: #333; }
+        button { padding: 12px 24px; background: #3b82f6; color: white; border: none; border-radius: 6px; font-weight: 600; cursor: pointer; }
+        a { color: #3b82f6; }
+    </style>
+</head>
+<body>
+    <h1>🎬 WatchClub</h1>
+    {{if .Error}}
+    <p>{{.Error}}</p>
+    {{else if .Done}}
+    <p>You've been unsubscribed from {{.Description}}.</p>
+    {{else}}
+    <p>Unsubscribe from {{.Description}}?</p>
+    <form method="post">
+        <button type="submit">Unsubscribe</button>
+    </form>
+    {{end}}
+    <p><a href="{{.BaseURL}}">Back to WatchClub</a></p>
+</body>
+</html>
+`))
+
+var notificationDescriptions = map[v1.NotificationType]string{
+	v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED:     "club started emails",
+	v1.NotificationType_NOTIFICATION_TYPE_REMINDERS:        "reminder emails",
+	v1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_CHANGES: "schedule change emails",
+	v1.NotificationType_NOTIFICATION_TYPE_DIGESTS:          "digest emails",
+}
+
+// UnsubscribeHandler serves the links in notification emails.
+// GET shows a confirmation page, since link scanners may follow links in emails;
+// POST unsubscribes, and supports one-click unsubscribe from mail clients (RFC 8058).
+func (s *WatchClubService) UnsubscribeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := struct {
+			BaseURL     string
+			Description string
+			Error       string
+			Done        bool
+		}{
+			BaseURL: s.baseURL,
+		}
+		render := func(code int) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(code)
+			unsubscribePage.Execute(w, page)
+		}
+
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		token, err := s.unsubscribe.Verify(r.URL.Query().Get("token"))
+		if err != nil {
+			page.Error = "This unsubscribe link is invalid."
+			render(http.StatusBadRequest)
+			return
+		}
+
+		page.Description = notificationDescriptions[token.Type]
+		if page.Description == "" {
+			page.Description = "these emails"
+		}
+		if token.ClubID != "" {
+			if club, err := s.storage.GetClub(r.Context(), token.ClubID); err == nil {
+				page.Description += " about " + club.Name
+			}
+		}
+
+		if r.Method == http.MethodGet {
+			render(http.StatusOK)
+			return
+		}
+
+		prefs := s.notificationPreferences(r.Context(), token.UserID)
+		applyUnsubscribe(prefs, token)
+		prefs.UpdatedAt = timestamppb.Now()
+		if err := s.storage.PutNotificationPreferences(r.Context(), prefs); err != nil {
+			s.logger.Error("Failed to save notification preferences",
+				zap.String("userId", token.UserID),
+				zap.Error(err))
+			page.Error = "Something went wrong. Please try again later."
+			render(http.StatusInternalServerError)
+			return
+		}
+
+		s.logger.Info("User unsubscribed",
+			zap.String("userId", token.UserID),
+			zap.String("clubId", token.ClubID),
+			zap.Stringer("type", token.Type))
+
+		page.Done = true
+		render(http.StatusOK)
+	})
+}
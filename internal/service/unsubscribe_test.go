@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/mail"
+	"github.com/cartermckinnon/watchclub/internal/storage"
+)
+
+func Test_UnsubscribeHandler(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStorage()
+	require.NoError(t, store.CreateUser(ctx, &v1.User{Id: "user-1", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, store.CreateClub(ctx, &v1.Club{Id: "club-1", Name: "Movie Night"}))
+
+	signer := mail.NewUnsubscribeSigner([]byte("secret"))
+	svc := New(Config{
+		Storage:     store,
+		Unsubscribe: signer,
+		BaseURL:     "https://watchclub.example.com/",
+		Logger:      zap.NewNop(),
+	})
+	handler := svc.UnsubscribeHandler()
+
+	token := signer.Sign(mail.UnsubscribeToken{
+		UserID: "user-1",
+		ClubID: "club-1",
+		Type:   v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED,
+	})
+
+	// GET only asks for confirmation
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/unsubscribe?token="+token, nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "Unsubscribe from club started emails about Movie Night?")
+	prefs := svc.notificationPreferences(ctx, "user-1")
+	assert.True(t, notificationEnabled(prefs, "club-1", v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED))
+
+	// one-click POST (RFC 8058)
+	resp = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/unsubscribe?token="+token, strings.NewReader("List-Unsubscribe=One-Click"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	handler.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	prefs = svc.notificationPreferences(ctx, "user-1")
+	assert.False(t, notificationEnabled(prefs, "club-1", v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED))
+	assert.True(t, notificationEnabled(prefs, "club-2", v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED))
+	assert.True(t, notificationEnabled(prefs, "club-1", v1.NotificationType_NOTIFICATION_TYPE_REMINDERS))
+
+	// forged tokens are rejected
+	forged := mail.NewUnsubscribeSigner([]byte("guess")).Sign(mail.UnsubscribeToken{UserID: "user-1"})
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/unsubscribe?token="+forged, nil))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func Test_NotificationEnabled(t *testing.T) {
+	clubStarted := v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED
+	digests := v1.NotificationType_NOTIFICATION_TYPE_DIGESTS
+
+	assert.True(t, notificationEnabled(&v1.NotificationPreferences{}, "club-1", clubStarted))
+	assert.False(t, notificationEnabled(&v1.NotificationPreferences{UnsubscribedAll: true}, "club-1", clubStarted))
+	assert.False(t, notificationEnabled(&v1.NotificationPreferences{DisabledTypes: []v1.NotificationType{digests}}, "", digests))
+
+	muted := &v1.NotificationPreferences{Clubs: []*v1.ClubNotificationPreferences{{ClubId: "club-1", Muted: true}}}
+	assert.False(t, notificationEnabled(muted, "club-1", clubStarted))
+	assert.True(t, notificationEnabled(muted, "club-2", clubStarted))
+}
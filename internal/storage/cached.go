@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// CacheOptions configures Cached
+type CacheOptions struct {
+	// Size is the most entries the cache holds; the least recently used are evicted to make room
+	Size int
+	// TTL is how long entries are used for, which bounds how stale they can be when other processes write to the storage
+	TTL time.Duration
+}
+
+// CacheStats counts the cache's lookups since it was created
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// CachedStorage is a read-through cache in front of another Storage
+type CachedStorage struct {
+	Storage
+	opts CacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	// generation is incremented on every invalidation, so that a lookup that raced with a write doesn't cache what it read
+	generation uint64
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type cacheEntry struct {
+	key     string
+	value   any
+	expires time.Time
+}
+
+// Cached wraps storage in a cache of users, clubs, and club's picks, which is invalidated by writes made through it.
+// Writes made elsewhere, such as by another server sharing the database, are seen once the entries expire.
+func Cached(s Storage, opts CacheOptions) *CachedStorage {
+	return &CachedStorage{
+		Storage: s,
+		opts:    opts,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Stats returns the number of cache hits, misses, and evictions
+func (c *CachedStorage) Stats() CacheStats {
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+// get returns a cached value, and the generation to pass to put if it wasn't cached
+func (c *CachedStorage) get(key string) (any, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			c.lru.MoveToFront(element)
+			c.hits.Add(1)
+			return entry.value, c.generation, true
+		}
+		c.remove(element)
+	}
+	c.misses.Add(1)
+	return nil, c.generation, false
+}
+
+// put caches a value read from the storage, unless it's been written to since the read began
+func (c *CachedStorage) put(key string, value any, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation || c.opts.Size <= 0 {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, expires: time.Now().Add(c.opts.TTL)})
+	for c.lru.Len() > c.opts.Size {
+		c.remove(c.lru.Back())
+		c.evictions.Add(1)
+	}
+}
+
+func (c *CachedStorage) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).key)
+}
+
+// invalidate removes the entries with the given keys, or every entry matching a key that ends with *
+func (c *CachedStorage) invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for _, key := range keys {
+		if prefix, ok := strings.CutSuffix(key, "*"); ok {
+			for k, element := range c.entries {
+				if strings.HasPrefix(k, prefix) {
+					c.remove(element)
+				}
+			}
+		} else if element, ok := c.entries[key]; ok {
+			c.remove(element)
+		}
+	}
+}
+
+// invalidateAll empties the cache, for writes that cascade to records that can't be found cheaply
+func (c *CachedStorage) invalidateAll() {
+	c.invalidate("*")
+}
+
+func cacheUserKey(id string) string      { return "user/" + id }
+func cacheClubKey(id string) string      { return "club/" + id }
+func cachePicksKey(clubID string) string { return "picks/" + clubID }
+
+// cachedGet reads a record through the cache, which holds copies so that callers can't change what's cached
+func cachedGet[T proto.Message](c *CachedStorage, key string, load func() (T, error)) (T, error) {
+	cached, generation, ok := c.get(key)
+	if ok {
+		return proto.Clone(cached.(T)).(T), nil
+	}
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	c.put(key, proto.Clone(value), generation)
+	return value, nil
+}
+
+func cloneAll[T proto.Message](values []T) []T {
+	clones := make([]T, len(values))
+	for i, value := range values {
+		clones[i] = proto.Clone(value).(T)
+	}
+	return clones
+}
+
+func (c *CachedStorage) CreateUser(ctx context.Context, user *v1.User) error {
+	defer c.invalidate(cacheUserKey(user.Id))
+	return c.Storage.CreateUser(ctx, user)
+}
+
+func (c *CachedStorage) GetUser(ctx context.Context, id string) (*v1.User, error) {
+	return cachedGet(c, cacheUserKey(id), func() (*v1.User, error) {
+		return c.Storage.GetUser(ctx, id)
+	})
+}
+
+func (c *CachedStorage) GetUsers(ctx context.Context, ids []string) ([]*v1.User, error) {
+	found := make(map[string]*v1.User, len(ids))
+	var missing []string
+	var generation uint64
+	for _, id := range ids {
+		if _, ok := found[id]; ok {
+			continue
+		}
+		value, g, ok := c.get(cacheUserKey(id))
+		if ok {
+			found[id] = proto.Clone(value.(*v1.User)).(*v1.User)
+			continue
+		}
+		if missing == nil {
+			generation = g
+		}
+		missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		loaded, err := c.Storage.GetUsers(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range loaded {
+			found[user.Id] = user
+			c.put(cacheUserKey(user.Id), proto.Clone(user), generation)
+		}
+	}
+
+	users := make([]*v1.User, 0, len(found))
+	for _, id := range ids {
+		if user, ok := found[id]; ok {
+			users = append(users, user)
+			delete(found, id)
+		}
+	}
+	return users, nil
+}
+
+func (c *CachedStorage) DeleteUser(ctx context.Context, id string) error {
+	// deleting a user deletes their picks and memberships too
+	defer c.invalidateAll()
+	return c.Storage.DeleteUser(ctx, id)
+}
+
+func (c *CachedStorage) CreateClub(ctx context.Context, club *v1.Club) error {
+	defer c.invalidate(cacheClubKey(club.Id))
+	return c.Storage.CreateClub(ctx, club)
+}
+
+func (c *CachedStorage) GetClub(ctx context.Context, id string) (*v1.Club, error) {
+	return cachedGet(c, cacheClubKey(id), func() (*v1.Club, error) {
+		return c.Storage.GetClub(ctx, id)
+	})
+}
+
+func (c *CachedStorage) UpdateClub(ctx context.Context, club *v1.Club) error {
+	defer c.invalidate(cacheClubKey(club.Id))
+	return c.Storage.UpdateClub(ctx, club)
+}
+
+func (c *CachedStorage) ResetSchedule(ctx context.Context, club *v1.Club) ([]*v1.ScheduledPick, error) {
+	defer c.invalidate(cacheClubKey(club.Id))
+	return c.Storage.ResetSchedule(ctx, club)
+}
+
+func (c *CachedStorage) DeleteClub(ctx context.Context, id string) error {
+	defer c.invalidate(cacheClubKey(id), cachePicksKey(id))
+	return c.Storage.DeleteClub(ctx, id)
+}
+
+func (c *CachedStorage) RestoreClub(ctx context.Context, id string) error {
+	defer c.invalidate(cacheClubKey(id), cachePicksKey(id))
+	return c.Storage.RestoreClub(ctx, id)
+}
+
+func (c *CachedStorage) CreatePick(ctx context.Context, pick *v1.Pick) error {
+	defer c.invalidate(cachePicksKey(pick.ClubId))
+	return c.Storage.CreatePick(ctx, pick)
+}
+
+func (c *CachedStorage) ListPicks(ctx context.Context, clubID string) ([]*v1.Pick, error) {
+	key := cachePicksKey(clubID)
+	cached, generation, ok := c.get(key)
+	if ok {
+		return cloneAll(cached.([]*v1.Pick)), nil
+	}
+	picks, err := c.Storage.ListPicks(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, cloneAll(picks), generation)
+	return picks, nil
+}
+
+func (c *CachedStorage) DeletePick(ctx context.Context, id string) error {
+	// the pick's club isn't known without looking it up, so drop every club's picks
+	defer c.invalidate(cachePicksKey("*"))
+	return c.Storage.DeletePick(ctx, id)
+}
+
+func (c *CachedStorage) RestorePick(ctx context.Context, id string) error {
+	// the pick's club isn't known without looking it up, so drop every club's picks
+	defer c.invalidate(cachePicksKey("*"))
+	return c.Storage.RestorePick(ctx, id)
+}
+
+func (c *CachedStorage) CheckConsistency(ctx context.Context, repair bool) ([]Orphan, error) {
+	if repair {
+		defer c.invalidateAll()
+	}
+	return c.Storage.CheckConsistency(ctx, repair)
+}
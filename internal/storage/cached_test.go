@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_Cached_Conformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		return Cached(NewMemoryStorage(), CacheOptions{Size: 100, TTL: time.Minute})
+	})
+}
+
+func Test_Cached(t *testing.T) {
+	ctx := context.Background()
+	backing := NewMemoryStorage().(*memoryStorage)
+	c := Cached(backing, CacheOptions{Size: 3, TTL: time.Minute})
+
+	require.NoError(t, c.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, c.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, c.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}))
+
+	// reads are cached
+	_, err := c.GetClub(ctx, "club")
+	require.NoError(t, err)
+	club, err := c.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, c.Stats())
+
+	// writes through the cache invalidate it
+	club.MemberIds = append(club.MemberIds, "sam")
+	require.NoError(t, c.UpdateClub(ctx, club))
+	club, err = c.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jo", "sam"}, club.MemberIds)
+
+	_, err = c.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	require.NoError(t, c.CreatePick(ctx, &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}))
+	picks, err := c.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, picks, 1)
+	require.NoError(t, c.DeletePick(ctx, "heat"))
+	picks, err = c.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	assert.Empty(t, picks)
+
+	// batch lookups use cached users and only load the rest
+	_, err = c.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	before := c.Stats()
+	users, err := c.GetUsers(ctx, []string{"sam", "jo", "gone", "sam"})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "sam", users[0].Id)
+	assert.Equal(t, "jo", users[1].Id)
+	assert.Equal(t, before.Hits+1, c.Stats().Hits)
+	_, err = c.GetUser(ctx, "sam")
+	require.NoError(t, err)
+	assert.Equal(t, before.Hits+2, c.Stats().Hits)
+
+	// the least recently used entries are evicted to stay within the size
+	assert.LessOrEqual(t, c.lru.Len(), 3)
+	assert.NotZero(t, c.Stats().Evictions)
+
+	// writes made elsewhere are seen once entries expire
+	backing.users["jo"].Name = "Joanna"
+	user, err := c.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Equal(t, "Jo", user.Name)
+	c.opts.TTL = 0
+	c.invalidateAll()
+	_, err = c.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	user, err = c.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Equal(t, "Joanna", user.Name)
+
+	// deleting a user cascades, so it drops everything
+	c.opts.TTL = time.Minute
+	_, err = c.GetClub(ctx, "club")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteUser(ctx, "sam"))
+	club, err = c.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jo"}, club.MemberIds)
+	_, err = c.GetUser(ctx, "sam")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
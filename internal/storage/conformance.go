@@ -0,0 +1,872 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// RunConformanceTests checks that a Storage implementation behaves the way the service expects.
+// newStorage is called for each subtest and must return an empty storage.
+func RunConformanceTests(t *testing.T, newStorage func(t *testing.T) Storage) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s Storage)
+	}{
+		{"Users", conformUsers},
+		{"Clubs", conformClubs},
+		{"Picks", conformPicks},
+		{"ScheduledPicks", conformScheduledPicks},
+		{"ResetSchedule", conformResetSchedule},
+		{"NotificationPreferences", conformNotificationPreferences},
+		{"CalendarFeeds", conformCalendarFeeds},
+		{"AppPasswords", conformAppPasswords},
+		{"Queries", conformQueries},
+		{"Copies", conformCopies},
+		{"DeleteUser", conformDeleteUser},
+		{"DeleteClub", conformDeleteClub},
+		{"Concurrency", conformConcurrency},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.run(t, newStorage(t))
+		})
+	}
+}
+
+func assertProtoEqual(t *testing.T, want proto.Message, got proto.Message) {
+	t.Helper()
+	assert.True(t, proto.Equal(want, got), "want %v\ngot  %v", want, got)
+}
+
+// assertNotFound checks that an error is ErrNotFound with a message mentioning what wasn't found
+func assertNotFound(t *testing.T, err error, message string, msgAndArgs ...any) {
+	t.Helper()
+	assert.ErrorIs(t, err, ErrNotFound, msgAndArgs...)
+	assert.ErrorContains(t, err, message, msgAndArgs...)
+}
+
+func assertProtosEqual[T proto.Message](t *testing.T, want []T, got []T) {
+	t.Helper()
+	if !assert.Len(t, got, len(want)) {
+		return
+	}
+	for i := range want {
+		assertProtoEqual(t, want[i], got[i])
+	}
+}
+
+// conformTime is a fixed time with nanoseconds, which every backend must keep
+func conformTime(minutes int) *timestamppb.Timestamp {
+	return timestamppb.New(time.Date(2026, time.March, 1, 12, minutes, 0, 123456789, time.UTC))
+}
+
+// conformFixture creates users jo and sam, and a club with both of them as members
+func conformFixture(t *testing.T, s Storage) {
+	ctx := context.Background()
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com", CreatedAt: conformTime(0)}))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com", CreatedAt: conformTime(1)}))
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"sam", "jo"}, CreatedAt: conformTime(2)}))
+}
+
+func conformUsers(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	users, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	jo := &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com", CreatedAt: conformTime(5)}
+	sam := &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com", CreatedAt: conformTime(1)}
+	al := &v1.User{Id: "al", Name: "Al", Email: "al@example.com", CreatedAt: conformTime(1)}
+	legacy := &v1.User{Id: "legacy", Name: "Legacy", Email: "legacy@example.com"}
+	for _, user := range []*v1.User{jo, sam, al, legacy} {
+		require.NoError(t, s.CreateUser(ctx, user))
+	}
+	assert.ErrorIs(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo2@example.com"}), ErrAlreadyExists, "IDs are unique")
+	assert.ErrorIs(t, s.CreateUser(ctx, &v1.User{Id: "jo2", Name: "Jo", Email: "jo@example.com"}), ErrAlreadyExists, "emails are unique")
+
+	got, err := s.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, jo, got)
+	got, err = s.GetUserByEmail(ctx, "sam@example.com")
+	require.NoError(t, err)
+	assertProtoEqual(t, sam, got)
+
+	_, err = s.GetUser(ctx, "nobody")
+	assertNotFound(t, err, "user not found: nobody")
+	_, err = s.GetUserByEmail(ctx, "nobody@example.com")
+	assertNotFound(t, err, "user not found")
+
+	// unset creation times first, then by creation time, then by ID
+	users, err = s.ListUsers(ctx)
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.User{legacy, al, sam, jo}, users)
+
+	// batches are in the order asked for, without missing or repeated users
+	users, err = s.GetUsers(ctx, []string{"sam", "nobody", "jo", "sam"})
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.User{sam, jo}, users)
+	users, err = s.GetUsers(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	require.NoError(t, s.DeleteUser(ctx, "jo"))
+	_, err = s.GetUser(ctx, "jo")
+	assertNotFound(t, err, "not found")
+	assertNotFound(t, s.DeleteUser(ctx, "jo"), "user not found: jo")
+	// the email can be used again
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo2", Name: "Jo", Email: "jo@example.com"}))
+}
+
+func conformClubs(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	club, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sam", "jo"}, club.MemberIds, "members keep their order")
+
+	assert.ErrorIs(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Again"}), ErrAlreadyExists, "IDs are unique")
+	assert.ErrorIs(t, s.CreateClub(ctx, &v1.Club{Id: "ghosts", Name: "Ghosts", MemberIds: []string{"jo", "nobody"}}), ErrConflict, "members must exist")
+	_, err = s.GetClub(ctx, "ghosts")
+	assertNotFound(t, err, "club not found: ghosts", "failed clubs aren't partially created")
+
+	started := &v1.Club{
+		Id:                       "started",
+		Name:                     "Started",
+		MemberIds:                []string{"jo"},
+		StartDate:                conformTime(10),
+		Started:                  true,
+		CreatedAt:                conformTime(0),
+		UpdatedAt:                conformTime(10),
+		MaxPicksPerMember:        2,
+		ScheduleIntervalQuantity: 1,
+		ScheduleIntervalUnit:     v1.ScheduleIntervalUnit_SCHEDULE_INTERVAL_UNIT_WEEKS,
+	}
+	require.NoError(t, s.CreateClub(ctx, started))
+	empty := &v1.Club{Id: "empty", Name: "Empty"}
+	require.NoError(t, s.CreateClub(ctx, empty))
+
+	clubs, err := s.ListClubs(ctx)
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Club{empty, started, club}, clubs)
+	clubs, err = s.ListClubsForUser(ctx, "jo")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Club{started, club}, clubs)
+	clubs, err = s.ListClubsForUser(ctx, "nobody")
+	require.NoError(t, err)
+	assert.Empty(t, clubs)
+
+	club.MemberIds = []string{"jo"}
+	club.Started = true
+	club.UpdatedAt = conformTime(20)
+	require.NoError(t, s.UpdateClub(ctx, club))
+	got, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assertProtoEqual(t, club, got)
+	clubs, err = s.ListClubsForUser(ctx, "sam")
+	require.NoError(t, err)
+	assert.Empty(t, clubs)
+
+	assert.ErrorIs(t, s.UpdateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"nobody"}}), ErrConflict, "members must exist")
+	got, err = s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assertProtoEqual(t, club, got)
+	assert.ErrorIs(t, s.UpdateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo", "jo"}}), ErrAlreadyExists, "members are listed once")
+	assertNotFound(t, s.UpdateClub(ctx, &v1.Club{Id: "nowhere"}), "club not found: nowhere")
+
+	require.NoError(t, s.DeleteClub(ctx, "empty"))
+	_, err = s.GetClub(ctx, "empty")
+	assertNotFound(t, err, "club not found: empty")
+	assertNotFound(t, s.DeleteClub(ctx, "empty"), "club not found: empty")
+}
+
+func conformPicks(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "other", Name: "Other", MemberIds: []string{"jo"}}))
+
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", Year: 1995, Notes: "long", Link: "https://example.com", CreatedAt: conformTime(3)}
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien", Year: 1979, CreatedAt: conformTime(3)}
+	brazil := &v1.Pick{Id: "brazil", ClubId: "club", UserId: "sam", Title: "Brazil", CreatedAt: conformTime(1)}
+	elsewhere := &v1.Pick{Id: "elsewhere", ClubId: "other", UserId: "jo", Title: "Elsewhere"}
+	for _, pick := range []*v1.Pick{heat, alien, brazil, elsewhere} {
+		require.NoError(t, s.CreatePick(ctx, pick))
+	}
+	assert.ErrorIs(t, s.CreatePick(ctx, &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}), ErrAlreadyExists, "IDs are unique")
+	assert.ErrorIs(t, s.CreatePick(ctx, &v1.Pick{Id: "lost", ClubId: "nowhere", UserId: "jo", Title: "Lost"}), ErrConflict, "clubs must exist")
+	assert.ErrorIs(t, s.CreatePick(ctx, &v1.Pick{Id: "lost", ClubId: "club", UserId: "nobody", Title: "Lost"}), ErrConflict, "users must exist")
+
+	got, err := s.GetPick(ctx, "heat")
+	require.NoError(t, err)
+	assertProtoEqual(t, heat, got)
+	_, err = s.GetPick(ctx, "lost")
+	assertNotFound(t, err, "pick not found: lost")
+
+	picks, err := s.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{brazil, alien, heat}, picks)
+	picks, err = s.ListPicks(ctx, "nowhere")
+	require.NoError(t, err)
+	assert.Empty(t, picks)
+
+	picks, err = s.ListPicksForClubs(ctx, []string{"club", "other", "nowhere"})
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{elsewhere, brazil, alien, heat}, picks)
+	picks, err = s.ListPicksForClubs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, picks)
+
+	require.NoError(t, s.DeletePick(ctx, "heat"))
+	_, err = s.GetPick(ctx, "heat")
+	assertNotFound(t, err, "pick not found: heat")
+	assertNotFound(t, s.DeletePick(ctx, "heat"), "pick not found: heat")
+}
+
+func conformScheduledPicks(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", CreatedAt: conformTime(3)}
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien"}
+	require.NoError(t, s.CreatePick(ctx, heat))
+	require.NoError(t, s.CreatePick(ctx, alien))
+
+	second := &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 2, StartDate: conformTime(30), Pick: heat, CalendarUid: "a@watchclub", CalendarSequence: 1}
+	first := &v1.ScheduledPick{Id: "b", ClubId: "club", SequenceNumber: 1, StartDate: conformTime(20), Pick: alien, CalendarUid: "b@watchclub"}
+	require.NoError(t, s.CreateScheduledPick(ctx, second))
+	require.NoError(t, s.CreateScheduledPick(ctx, first))
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 3, Pick: heat}), ErrAlreadyExists, "IDs are unique")
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "nowhere", SequenceNumber: 3, Pick: heat}), ErrConflict, "clubs must exist")
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "club", SequenceNumber: 3, Pick: &v1.Pick{Id: "lost"}}), ErrConflict, "picks must exist")
+	assert.Error(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "club", SequenceNumber: 3}), "picks are required")
+
+	got, err := s.GetScheduledPick(ctx, "a")
+	require.NoError(t, err)
+	assertProtoEqual(t, second, got)
+	_, err = s.GetScheduledPick(ctx, "c")
+	assertNotFound(t, err, "scheduled pick not found: c")
+
+	// scheduled picks come with the stored pick, whatever version of it they were saved with
+	stale := proto.Clone(first).(*v1.ScheduledPick)
+	stale.Pick.Title = "Aliens"
+	stale.CalendarSequence = 2
+	require.NoError(t, s.UpdateScheduledPick(ctx, stale))
+	first.CalendarSequence = 2
+	got, err = s.GetScheduledPick(ctx, "b")
+	require.NoError(t, err)
+	assertProtoEqual(t, first, got)
+
+	assignments, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{first, second}, assignments)
+	assignments, err = s.ListScheduledPicks(ctx, "nowhere")
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "another", Name: "Another", MemberIds: []string{"jo"}}))
+	elsewhere := &v1.Pick{Id: "elsewhere", ClubId: "another", UserId: "jo", Title: "Elsewhere"}
+	require.NoError(t, s.CreatePick(ctx, elsewhere))
+	third := &v1.ScheduledPick{Id: "c", ClubId: "another", SequenceNumber: 1, Pick: elsewhere}
+	require.NoError(t, s.CreateScheduledPick(ctx, third))
+	assignments, err = s.ListScheduledPicksForClubs(ctx, []string{"club", "another", "nowhere"})
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{third, first, second}, assignments)
+	assignments, err = s.ListScheduledPicksForClubs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+	require.NoError(t, s.DeleteClub(ctx, "another"))
+
+	// swapping picks
+	first.Pick, second.Pick = heat, alien
+	require.NoError(t, s.UpdateScheduledPick(ctx, first))
+	require.NoError(t, s.UpdateScheduledPick(ctx, second))
+	assignments, err = s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{first, second}, assignments)
+
+	assertNotFound(t, s.UpdateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "club", Pick: heat}), "scheduled pick not found: c")
+	assert.ErrorIs(t, s.UpdateScheduledPick(ctx, &v1.ScheduledPick{Id: "a", ClubId: "club", Pick: &v1.Pick{Id: "lost"}}), ErrConflict, "picks must exist")
+
+	assert.ErrorIs(t, s.DeletePick(ctx, "heat"), ErrConflict, "scheduled picks can't be deleted")
+	require.NoError(t, s.DeleteScheduledPick(ctx, "b"))
+	_, err = s.GetScheduledPick(ctx, "b")
+	assertNotFound(t, err, "scheduled pick not found: b")
+	assertNotFound(t, s.DeleteScheduledPick(ctx, "b"), "scheduled pick not found: b")
+	require.NoError(t, s.DeletePick(ctx, "heat"))
+}
+
+func conformResetSchedule(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "other", Name: "Other", MemberIds: []string{"jo"}}))
+
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien"}
+	brazil := &v1.Pick{Id: "brazil", ClubId: "other", UserId: "jo", Title: "Brazil"}
+	for _, pick := range []*v1.Pick{heat, alien, brazil} {
+		require.NoError(t, s.CreatePick(ctx, pick))
+	}
+	second := &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 2, Pick: heat}
+	first := &v1.ScheduledPick{Id: "b", ClubId: "club", SequenceNumber: 1, Pick: alien}
+	other := &v1.ScheduledPick{Id: "c", ClubId: "other", SequenceNumber: 1, Pick: brazil}
+	for _, assignment := range []*v1.ScheduledPick{second, first, other} {
+		require.NoError(t, s.CreateScheduledPick(ctx, assignment))
+	}
+
+	// nothing changes if the club can't be saved
+	_, err := s.ResetSchedule(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"nobody"}})
+	assert.ErrorIs(t, err, ErrConflict, "members must exist")
+	_, err = s.ResetSchedule(ctx, &v1.Club{Id: "nowhere", Name: "Nowhere"})
+	assertNotFound(t, err, "club not found: nowhere")
+	assignments, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{first, second}, assignments)
+
+	club := &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"sam", "jo"}, CreatedAt: conformTime(2), UpdatedAt: conformTime(5)}
+	deleted, err := s.ResetSchedule(ctx, club)
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{first, second}, deleted)
+	assignments, err = s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+	got, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assertProtoEqual(t, club, got)
+
+	// other clubs are left alone
+	assignments, err = s.ListScheduledPicks(ctx, "other")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.ScheduledPick{other}, assignments)
+
+	deleted, err = s.ResetSchedule(ctx, club)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+}
+
+func conformNotificationPreferences(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	_, err := s.GetNotificationPreferences(ctx, "jo")
+	assertNotFound(t, err, "notification preferences not found: jo")
+
+	prefs := &v1.NotificationPreferences{
+		UserId:        "jo",
+		DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_REMINDERS, v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED},
+		Clubs: []*v1.ClubNotificationPreferences{
+			{ClubId: "club", Muted: true},
+			// clubs in preferences don't have to exist, because unsubscribe links can outlive their club
+			{ClubId: "gone", DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_REMINDERS}},
+		},
+		UpdatedAt:        conformTime(5),
+		DigestWeekday:    v1.Weekday_WEEKDAY_FRIDAY,
+		LastDigestSentAt: conformTime(6),
+	}
+	require.NoError(t, s.PutNotificationPreferences(ctx, prefs))
+	got, err := s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, prefs, got)
+
+	// putting replaces everything
+	prefs = &v1.NotificationPreferences{UserId: "jo", UnsubscribedAll: true, Clubs: []*v1.ClubNotificationPreferences{{ClubId: "gone", Muted: true}}}
+	require.NoError(t, s.PutNotificationPreferences(ctx, prefs))
+	got, err = s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, prefs, got)
+
+	assert.ErrorIs(t, s.PutNotificationPreferences(ctx, &v1.NotificationPreferences{UserId: "nobody"}), ErrConflict, "users must exist")
+	duplicate := &v1.NotificationPreferences{UserId: "sam", Clubs: []*v1.ClubNotificationPreferences{{ClubId: "club"}, {ClubId: "club", Muted: true}}}
+	assert.ErrorIs(t, s.PutNotificationPreferences(ctx, duplicate), ErrAlreadyExists, "clubs are listed once")
+	got, err = s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, prefs, got)
+}
+
+func conformCalendarFeeds(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	_, err := s.GetCalendarFeed(ctx, "jo")
+	assertNotFound(t, err, "calendar feed not found: jo")
+	_, err = s.GetCalendarFeedByToken(ctx, "token")
+	assertNotFound(t, err, "calendar feed not found")
+
+	feed := &v1.CalendarFeed{UserId: "jo", Token: "token", CreatedAt: conformTime(5)}
+	require.NoError(t, s.PutCalendarFeed(ctx, feed))
+	got, err := s.GetCalendarFeed(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, feed, got)
+	got, err = s.GetCalendarFeedByToken(ctx, "token")
+	require.NoError(t, err)
+	assertProtoEqual(t, feed, got)
+
+	// putting again rotates the token
+	feed = &v1.CalendarFeed{UserId: "jo", Token: "rotated", CreatedAt: conformTime(6)}
+	require.NoError(t, s.PutCalendarFeed(ctx, feed))
+	_, err = s.GetCalendarFeedByToken(ctx, "token")
+	assertNotFound(t, err, "calendar feed not found")
+	got, err = s.GetCalendarFeedByToken(ctx, "rotated")
+	require.NoError(t, err)
+	assertProtoEqual(t, feed, got)
+
+	assert.ErrorIs(t, s.PutCalendarFeed(ctx, &v1.CalendarFeed{UserId: "sam", Token: "rotated"}), ErrAlreadyExists, "tokens are unique")
+	assert.ErrorIs(t, s.PutCalendarFeed(ctx, &v1.CalendarFeed{UserId: "nobody", Token: "other"}), ErrConflict, "users must exist")
+}
+
+func conformAppPasswords(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	appPasswords, err := s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	assert.Empty(t, appPasswords)
+
+	phone := &v1.AppPassword{Id: "phone", UserId: "jo", Name: "Phone", PasswordHash: "hash", CreatedAt: conformTime(5)}
+	laptop := &v1.AppPassword{Id: "laptop", UserId: "jo", Name: "Laptop", PasswordHash: "hash", CreatedAt: conformTime(1), LastUsedAt: conformTime(2)}
+	other := &v1.AppPassword{Id: "other", UserId: "sam", Name: "Other", PasswordHash: "hash"}
+	for _, appPassword := range []*v1.AppPassword{phone, laptop, other} {
+		require.NoError(t, s.CreateAppPassword(ctx, appPassword))
+	}
+	assert.ErrorIs(t, s.CreateAppPassword(ctx, &v1.AppPassword{Id: "phone", UserId: "jo", Name: "Phone", PasswordHash: "hash"}), ErrAlreadyExists, "IDs are unique")
+	assert.ErrorIs(t, s.CreateAppPassword(ctx, &v1.AppPassword{Id: "lost", UserId: "nobody", Name: "Lost", PasswordHash: "hash"}), ErrConflict, "users must exist")
+
+	appPasswords, err = s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.AppPassword{laptop, phone}, appPasswords)
+
+	phone.LastUsedAt = conformTime(10)
+	require.NoError(t, s.PutAppPassword(ctx, phone))
+	appPasswords, err = s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.AppPassword{laptop, phone}, appPasswords)
+	assert.ErrorIs(t, s.PutAppPassword(ctx, &v1.AppPassword{Id: "lost", UserId: "nobody", Name: "Lost", PasswordHash: "hash"}), ErrConflict, "users must exist")
+
+	require.NoError(t, s.DeleteAppPassword(ctx, "laptop"))
+	assertNotFound(t, s.DeleteAppPassword(ctx, "laptop"), "app password not found: laptop")
+	appPasswords, err = s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.AppPassword{phone}, appPasswords)
+}
+
+// conformCopies checks that changing objects after they're saved or loaded doesn't change what's stored
+// collectPages pages through a query two records at a time, checking that pages are full until the last one
+func collectPages[T proto.Message](t *testing.T, query func(page Page) ([]T, string, error)) []T {
+	t.Helper()
+	var all []T
+	page := Page{Size: 2}
+	for range 10 {
+		records, next, err := query(page)
+		require.NoError(t, err)
+		all = append(all, records...)
+		if next == "" {
+			return all
+		}
+		assert.Len(t, records, 2, "only the last page is partial")
+		page.Token = next
+	}
+	t.Fatal("too many pages")
+	return nil
+}
+
+func conformQueries(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+	started := true
+
+	// clubs with unset creation times, equal creation times, and different membership and states
+	var clubs []*v1.Club
+	for i, created := range []*timestamppb.Timestamp{nil, conformTime(1), conformTime(1), nil, conformTime(0)} {
+		club := &v1.Club{Id: fmt.Sprintf("c%d", i), Name: "Club", MemberIds: []string{"jo"}, CreatedAt: created, Started: i%2 == 0}
+		require.NoError(t, s.CreateClub(ctx, club))
+		clubs = append(clubs, club)
+	}
+	fixture, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+
+	got := collectPages(t, func(page Page) ([]*v1.Club, string, error) {
+		return s.QueryClubs(ctx, ClubQuery{Page: page})
+	})
+	assertProtosEqual(t, []*v1.Club{clubs[0], clubs[3], clubs[4], clubs[1], clubs[2], fixture}, got)
+	got = collectPages(t, func(page Page) ([]*v1.Club, string, error) {
+		return s.QueryClubs(ctx, ClubQuery{MemberID: "jo", Started: &started, Page: page})
+	})
+	assertProtosEqual(t, []*v1.Club{clubs[0], clubs[4], clubs[2]}, got)
+	got = collectPages(t, func(page Page) ([]*v1.Club, string, error) {
+		return s.QueryClubs(ctx, ClubQuery{MemberID: "sam", Page: page})
+	})
+	assertProtosEqual(t, []*v1.Club{fixture}, got)
+
+	// a page size of zero gets everything
+	got, next, err := s.QueryClubs(ctx, ClubQuery{})
+	require.NoError(t, err)
+	assert.Len(t, got, 6)
+	assert.Empty(t, next)
+
+	// tokens only work with the query they came from
+	_, next, err = s.QueryClubs(ctx, ClubQuery{Page: Page{Size: 1}})
+	require.NoError(t, err)
+	_, _, err = s.QueryClubs(ctx, ClubQuery{MemberID: "jo", Page: Page{Size: 1, Token: next}})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+	_, _, err = s.QueryClubs(ctx, ClubQuery{Page: Page{Size: 1, Token: "garbage"}})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// records added before the position of a page token don't shift the pages after it
+	first, next, err := s.QueryClubs(ctx, ClubQuery{Page: Page{Size: 3}})
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Club{clubs[0], clubs[3], clubs[4]}, first)
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "a", Name: "Club"}))
+	rest, next, err := s.QueryClubs(ctx, ClubQuery{Page: Page{Size: 3, Token: next}})
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Club{clubs[1], clubs[2], fixture}, rest)
+	assert.Empty(t, next)
+
+	var picks []*v1.Pick
+	for i, created := range []*timestamppb.Timestamp{conformTime(3), nil, conformTime(3), conformTime(1)} {
+		pick := &v1.Pick{Id: fmt.Sprintf("p%d", i), ClubId: "club", UserId: []string{"jo", "sam"}[i%2], Title: "Pick", CreatedAt: created}
+		require.NoError(t, s.CreatePick(ctx, pick))
+		picks = append(picks, pick)
+	}
+	require.NoError(t, s.CreatePick(ctx, &v1.Pick{Id: "elsewhere", ClubId: "c0", UserId: "jo", Title: "Pick"}))
+	gotPicks := collectPages(t, func(page Page) ([]*v1.Pick, string, error) {
+		return s.QueryPicks(ctx, PickQuery{ClubID: "club", Page: page})
+	})
+	assertProtosEqual(t, []*v1.Pick{picks[1], picks[3], picks[0], picks[2]}, gotPicks)
+	gotPicks = collectPages(t, func(page Page) ([]*v1.Pick, string, error) {
+		return s.QueryPicks(ctx, PickQuery{ClubID: "club", UserID: "jo", Page: page})
+	})
+	assertProtosEqual(t, []*v1.Pick{picks[0], picks[2]}, gotPicks)
+
+	var assignments []*v1.ScheduledPick
+	for i, pick := range picks {
+		assignment := &v1.ScheduledPick{Id: fmt.Sprintf("s%d", i), ClubId: "club", SequenceNumber: int32(4 - i), StartDate: conformTime(10 * (4 - i)), Pick: pick}
+		require.NoError(t, s.CreateScheduledPick(ctx, assignment))
+		assignments = append(assignments, assignment)
+	}
+	gotAssignments := collectPages(t, func(page Page) ([]*v1.ScheduledPick, string, error) {
+		return s.QueryScheduledPicks(ctx, ScheduledPickQuery{ClubID: "club", Page: page})
+	})
+	assertProtosEqual(t, []*v1.ScheduledPick{assignments[3], assignments[2], assignments[1], assignments[0]}, gotAssignments)
+	gotAssignments = collectPages(t, func(page Page) ([]*v1.ScheduledPick, string, error) {
+		return s.QueryScheduledPicks(ctx, ScheduledPickQuery{ClubID: "club", StartingFrom: conformTime(20).AsTime(), Page: page})
+	})
+	assertProtosEqual(t, []*v1.ScheduledPick{assignments[2], assignments[1], assignments[0]}, gotAssignments)
+
+	// later pages keep the first page's StartingFrom, since callers may compute it from the current time
+	from := conformTime(20).AsTime()
+	gotAssignments = collectPages(t, func(page Page) ([]*v1.ScheduledPick, string, error) {
+		query := ScheduledPickQuery{ClubID: "club", StartingFrom: from, Page: page}
+		from = from.Add(time.Hour)
+		return s.QueryScheduledPicks(ctx, query)
+	})
+	assertProtosEqual(t, []*v1.ScheduledPick{assignments[2], assignments[1], assignments[0]}, gotAssignments)
+	gotAssignments, _, err = s.QueryScheduledPicks(ctx, ScheduledPickQuery{ClubID: "nowhere"})
+	require.NoError(t, err)
+	assert.Empty(t, gotAssignments)
+}
+
+func conformCopies(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	user := &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}
+	require.NoError(t, s.CreateUser(ctx, user))
+	user.Name = "Changed"
+	got, err := s.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Equal(t, "Jo", got.Name)
+	got.Name = "Changed"
+	got, err = s.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Equal(t, "Jo", got.Name)
+	users, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	users[0].Name = "Changed"
+	got, err = s.GetUserByEmail(ctx, "jo@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Jo", got.Name)
+
+	club := &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}
+	require.NoError(t, s.CreateClub(ctx, club))
+	club.MemberIds[0] = "changed"
+	gotClub, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	gotClub.MemberIds = append(gotClub.MemberIds, "changed")
+	gotClub.Started = true
+	clubs, err := s.ListClubsForUser(ctx, "jo")
+	require.NoError(t, err)
+	require.Len(t, clubs, 1)
+	assert.Equal(t, []string{"jo"}, clubs[0].MemberIds)
+	assert.False(t, clubs[0].Started)
+
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	assignment := &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 1, Pick: pick}
+	require.NoError(t, s.CreateScheduledPick(ctx, assignment))
+	pick.Title = "Changed"
+	assignment.SequenceNumber = 2
+	gotAssignment, err := s.GetScheduledPick(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), gotAssignment.SequenceNumber)
+	assert.Equal(t, "Heat", gotAssignment.Pick.Title)
+	gotAssignment.Pick.Title = "Changed"
+	gotPick, err := s.GetPick(ctx, "heat")
+	require.NoError(t, err)
+	assert.Equal(t, "Heat", gotPick.Title)
+
+	prefs := &v1.NotificationPreferences{UserId: "jo", Clubs: []*v1.ClubNotificationPreferences{{ClubId: "club"}}}
+	require.NoError(t, s.PutNotificationPreferences(ctx, prefs))
+	prefs.Clubs[0].Muted = true
+	gotPrefs, err := s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assert.False(t, gotPrefs.Clubs[0].Muted)
+	gotPrefs.UnsubscribedAll = true
+	gotPrefs, err = s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assert.False(t, gotPrefs.UnsubscribedAll)
+}
+
+func conformDeleteUser(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "other", Name: "Other", MemberIds: []string{"jo", "sam"}}))
+
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien"}
+	require.NoError(t, s.CreatePick(ctx, heat))
+	require.NoError(t, s.CreatePick(ctx, alien))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 1, Pick: heat}))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "b", ClubId: "club", SequenceNumber: 2, Pick: alien}))
+	require.NoError(t, s.PutNotificationPreferences(ctx, &v1.NotificationPreferences{UserId: "jo", UnsubscribedAll: true}))
+	require.NoError(t, s.PutCalendarFeed(ctx, &v1.CalendarFeed{UserId: "jo", Token: "token"}))
+	require.NoError(t, s.CreateAppPassword(ctx, &v1.AppPassword{Id: "phone", UserId: "jo", Name: "Phone", PasswordHash: "hash"}))
+	require.NoError(t, s.CreateAppPassword(ctx, &v1.AppPassword{Id: "other", UserId: "sam", Name: "Other", PasswordHash: "hash"}))
+
+	// everything that belongs to the user is deleted with them
+	require.NoError(t, s.DeleteUser(ctx, "jo"))
+	for _, clubID := range []string{"club", "other"} {
+		club, err := s.GetClub(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sam"}, club.MemberIds)
+	}
+	_, err := s.GetPick(ctx, "heat")
+	assertNotFound(t, err, "pick not found: heat")
+	_, err = s.GetScheduledPick(ctx, "a")
+	assertNotFound(t, err, "scheduled pick not found: a")
+	_, err = s.GetNotificationPreferences(ctx, "jo")
+	assertNotFound(t, err, "not found")
+	_, err = s.GetCalendarFeed(ctx, "jo")
+	assertNotFound(t, err, "not found")
+	_, err = s.GetCalendarFeedByToken(ctx, "token")
+	assertNotFound(t, err, "not found")
+	appPasswords, err := s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	assert.Empty(t, appPasswords)
+
+	// other users' things aren't
+	picks, err := s.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{alien}, picks)
+	assignments, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, "b", assignments[0].Id)
+	appPasswords, err = s.ListAppPasswords(ctx, "sam")
+	require.NoError(t, err)
+	assert.Len(t, appPasswords, 1)
+
+	// a new user with the same ID starts from nothing
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	clubs, err := s.ListClubsForUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Empty(t, clubs)
+
+	assertNotFound(t, s.DeleteUser(ctx, "nobody"), "user not found: nobody")
+	orphans, err := s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func conformDeleteClub(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "other", Name: "Other", MemberIds: []string{"jo"}}))
+
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien"}
+	brazil := &v1.Pick{Id: "brazil", ClubId: "other", UserId: "jo", Title: "Brazil"}
+	for _, pick := range []*v1.Pick{heat, alien, brazil} {
+		require.NoError(t, s.CreatePick(ctx, pick))
+	}
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "a", ClubId: "club", SequenceNumber: 1, Pick: heat}))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "b", ClubId: "other", SequenceNumber: 1, Pick: brazil}))
+
+	// the club's picks and schedule are hidden with it, but its members aren't
+	require.NoError(t, s.DeleteClub(ctx, "club"))
+	_, err := s.GetClub(ctx, "club")
+	assertNotFound(t, err, "club not found: club")
+	for _, pickID := range []string{"heat", "alien"} {
+		_, err := s.GetPick(ctx, pickID)
+		assertNotFound(t, err, "pick not found: "+pickID)
+	}
+	_, err = s.GetScheduledPick(ctx, "a")
+	assertNotFound(t, err, "scheduled pick not found: a")
+	clubs, err := s.ListClubsForUser(ctx, "sam")
+	require.NoError(t, err)
+	assert.Empty(t, clubs)
+	for _, userID := range []string{"jo", "sam"} {
+		_, err = s.GetUser(ctx, userID)
+		require.NoError(t, err)
+	}
+
+	// other clubs are left alone
+	clubs, err = s.ListClubsForUser(ctx, "jo")
+	require.NoError(t, err)
+	require.Len(t, clubs, 1)
+	assert.Equal(t, "other", clubs[0].Id)
+	picks, err := s.ListPicks(ctx, "other")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{brazil}, picks)
+	_, err = s.GetScheduledPick(ctx, "b")
+	require.NoError(t, err)
+
+	assertNotFound(t, s.DeleteClub(ctx, "club"), "club not found: club")
+	assertNotFound(t, s.UpdateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night"}), "club not found: club")
+	assert.ErrorIs(t, s.CreatePick(ctx, &v1.Pick{Id: "lost", ClubId: "club", UserId: "jo", Title: "Lost"}), ErrConflict, "clubs must not be deleted")
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "club", SequenceNumber: 2, Pick: alien}), ErrConflict, "clubs must not be deleted")
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "other", SequenceNumber: 2, Pick: alien}), ErrConflict, "picks must not be deleted")
+	orphans, err := s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+
+	// members can see what was deleted, and restore it
+	deleted, err := s.ListDeletedClubs(ctx, "sam")
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "club", deleted[0].Id)
+	assert.NotNil(t, deleted[0].DeletedAt)
+	require.NoError(t, s.RestoreClub(ctx, "club"))
+	club, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Nil(t, club.DeletedAt)
+	picks, err = s.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{alien, heat}, picks)
+	_, err = s.GetScheduledPick(ctx, "a")
+	require.NoError(t, err)
+	deleted, err = s.ListDeletedClubs(ctx, "sam")
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	assertNotFound(t, s.RestoreClub(ctx, "club"), "deleted club not found: club")
+
+	// picks are deleted the same way
+	clue := &v1.Pick{Id: "clue", ClubId: "other", UserId: "jo", Title: "Clue"}
+	require.NoError(t, s.CreatePick(ctx, clue))
+	require.NoError(t, s.DeletePick(ctx, "clue"))
+	_, err = s.GetPick(ctx, "clue")
+	assertNotFound(t, err, "pick not found: clue")
+	assertNotFound(t, s.DeletePick(ctx, "clue"), "pick not found: clue")
+	assert.ErrorIs(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "c", ClubId: "other", SequenceNumber: 2, Pick: clue}), ErrConflict, "picks must not be deleted")
+	assert.ErrorIs(t, s.UpdateScheduledPick(ctx, &v1.ScheduledPick{Id: "b", ClubId: "other", SequenceNumber: 1, Pick: clue}), ErrConflict, "picks must not be deleted")
+	assignment, err := s.GetScheduledPick(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, "brazil", assignment.Pick.Id)
+	deletedPicks, err := s.ListDeletedPicks(ctx, "other", "jo")
+	require.NoError(t, err)
+	require.Len(t, deletedPicks, 1)
+	assert.Equal(t, "clue", deletedPicks[0].Id)
+	assert.NotNil(t, deletedPicks[0].DeletedAt)
+	deletedPicks, err = s.ListDeletedPicks(ctx, "other", "sam")
+	require.NoError(t, err)
+	assert.Empty(t, deletedPicks)
+	assertNotFound(t, s.RestorePick(ctx, "brazil"), "deleted pick not found: brazil")
+	// a deleted club's picks can't be restored until the club is
+	require.NoError(t, s.DeletePick(ctx, "alien"))
+	require.NoError(t, s.DeleteClub(ctx, "club"))
+	deletedPicks, err = s.ListDeletedPicks(ctx, "club", "sam")
+	require.NoError(t, err)
+	assert.Empty(t, deletedPicks)
+	assertNotFound(t, s.RestorePick(ctx, "alien"), "deleted pick not found: alien")
+	require.NoError(t, s.RestoreClub(ctx, "club"))
+	deletedPicks, err = s.ListDeletedPicks(ctx, "club", "sam")
+	require.NoError(t, err)
+	require.Len(t, deletedPicks, 1)
+	assert.Equal(t, "alien", deletedPicks[0].Id)
+	require.NoError(t, s.RestorePick(ctx, "alien"))
+	require.NoError(t, s.RestorePick(ctx, "clue"))
+	assertNotFound(t, s.RestorePick(ctx, "clue"), "deleted pick not found: clue")
+	restoredPick, err := s.GetPick(ctx, "clue")
+	require.NoError(t, err)
+	assert.Nil(t, restoredPick.DeletedAt)
+	deletedPicks, err = s.ListDeletedPicks(ctx, "other", "jo")
+	require.NoError(t, err)
+	assert.Empty(t, deletedPicks)
+	require.NoError(t, s.DeletePick(ctx, "clue"))
+
+	// purging deletes what was deleted before the cutoff for good
+	require.NoError(t, s.DeleteClub(ctx, "club"))
+	purged, err := s.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+	purged, err = s.PurgeDeleted(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, purged)
+	deleted, err = s.ListDeletedClubs(ctx, "sam")
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	assertNotFound(t, s.RestoreClub(ctx, "club"), "deleted club not found: club")
+	picks, err = s.ListPicks(ctx, "other")
+	require.NoError(t, err)
+	assertProtosEqual(t, []*v1.Pick{brazil}, picks)
+	orphans, err = s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+
+	// the IDs are free again
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"sam"}}))
+	require.NoError(t, s.CreatePick(ctx, clue))
+}
+
+func conformConcurrency(t *testing.T, s Storage) {
+	ctx := context.Background()
+	conformFixture(t, s)
+
+	const workers = 8
+	const perWorker = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*perWorker*3)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id := fmt.Sprintf("%d-%d", w, i)
+				errs <- s.CreateUser(ctx, &v1.User{Id: "user-" + id, Name: id, Email: id + "@example.com"})
+				errs <- s.CreatePick(ctx, &v1.Pick{Id: "pick-" + id, ClubId: "club", UserId: "jo", Title: id})
+				_, err := s.ListPicks(ctx, "club")
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	users, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, workers*perWorker+2)
+	picks, err := s.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	assert.Len(t, picks, workers*perWorker)
+}
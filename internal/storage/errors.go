@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors that every Storage implementation wraps, so that callers can tell what went wrong with errors.Is.
+// Any other error is a failure of the storage itself.
+var (
+	// ErrNotFound means the record being read, updated, or deleted doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists means a record with the same ID, or another unique value, already exists
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrConflict means a write would break a reference between records, e.g. a pick in a club that doesn't exist
+	// or was deleted, or deleting a pick that's still scheduled
+	ErrConflict = errors.New("conflict")
+	// ErrInvalidPageToken means a page token is malformed, or belongs to a different query
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// storageError is one of the errors above with a message that only names the records involved,
+// so that, unlike errors wrapping a driver's error, it's safe to show to clients
+type storageError struct {
+	sentinel error
+	message  string
+}
+
+func (e *storageError) Error() string {
+	return e.message
+}
+
+func (e *storageError) Unwrap() error {
+	return e.sentinel
+}
+
+// newError returns an error wrapping a sentinel, with a message that's safe to show to clients
+func newError(sentinel error, format string, args ...any) error {
+	return &storageError{sentinel: sentinel, message: fmt.Sprintf(format, args...)}
+}
+
+// notFound returns an ErrNotFound, e.g. "user not found: jo"
+func notFound(entity string, id string) error {
+	return newError(ErrNotFound, "%s %s: %s", entity, ErrNotFound, id)
+}
+
+// alreadyExists returns an ErrAlreadyExists, e.g. "user already exists: jo"
+func alreadyExists(entity string, id string) error {
+	return newError(ErrAlreadyExists, "%s %s: %s", entity, ErrAlreadyExists, id)
+}
+
+// conflict returns an ErrConflict, e.g. "conflict: pick is scheduled: heat"
+func conflict(format string, args ...any) error {
+	return newError(ErrConflict, "%s: %s", ErrConflict, fmt.Sprintf(format, args...))
+}
+
+// ClientMessage returns a message describing a storage error that's safe to show to clients: the message of the
+// error that names the records involved, or just the sentinel's when the error came from the database, whose messages
+// give away its internals
+func ClientMessage(err error) string {
+	var e *storageError
+	if errors.As(err, &e) {
+		return e.message
+	}
+	for _, sentinel := range []error{ErrNotFound, ErrAlreadyExists, ErrConflict, ErrInvalidPageToken} {
+		if errors.Is(err, sentinel) {
+			return sentinel.Error()
+		}
+	}
+	return "storage error"
+}
@@ -2,31 +2,141 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"time"
 
 	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/keyring"
 )
 
-// Storage defines the interface for persisting watchclub data
+// Storage defines the interface for persisting watchclub data.
+//
+// Implementations must pass RunConformanceTests, which pins down the behavior callers rely on:
+//   - Objects are copied in and out; changing one that was passed in or returned doesn't change what's stored.
+//   - Errors wrap ErrNotFound, ErrAlreadyExists, ErrConflict, and ErrInvalidPageToken; not found errors mention what
+//     wasn't found.
+//   - Lists are ordered by creation time (unset first) then ID, except scheduled picks, which are ordered by sequence number.
+//     Queries page through the same order, so that records added between pages don't shift the pages that follow.
+//   - Records must refer to records that exist and haven't been deleted, and picks can't be deleted while they're scheduled.
+//   - Deleting a user deletes everything that belongs to them in one transaction: their picks (and their scheduled
+//     picks), memberships, notification preferences, calendar feed, and app passwords.
+//   - Deleting a club or pick only marks it deleted, which hides it (and a club's picks and schedule) from reads until
+//     it's restored or purged. Purging a club deletes its picks, schedule, and memberships with it. Deleted records
+//     still exist as far as references are concerned.
 type Storage interface {
 	CreateUser(ctx context.Context, user *v1.User) error
 	GetUser(ctx context.Context, id string) (*v1.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*v1.User, error)
 	ListUsers(ctx context.Context) ([]*v1.User, error)
+	// GetUsers gets the users with the given IDs in one lookup, in the order of ids; users that don't exist are left out
+	GetUsers(ctx context.Context, ids []string) ([]*v1.User, error)
 	DeleteUser(ctx context.Context, id string) error
 
 	CreateClub(ctx context.Context, club *v1.Club) error
 	GetClub(ctx context.Context, id string) (*v1.Club, error)
 	ListClubs(ctx context.Context) ([]*v1.Club, error)
 	ListClubsForUser(ctx context.Context, userID string) ([]*v1.Club, error)
+	// QueryClubs gets a page of the clubs matching a query, and the token of the next page, which is empty on the last one
+	QueryClubs(ctx context.Context, query ClubQuery) ([]*v1.Club, string, error)
+	UpdateClub(ctx context.Context, club *v1.Club) error
+	// DeleteClub marks a club deleted, so that it can be restored until it's purged
 	DeleteClub(ctx context.Context, id string) error
+	// ListDeletedClubs lists the deleted clubs a user is a member of, with their deleted_at set
+	ListDeletedClubs(ctx context.Context, userID string) ([]*v1.Club, error)
+	RestoreClub(ctx context.Context, id string) error
 
 	CreatePick(ctx context.Context, pick *v1.Pick) error
 	GetPick(ctx context.Context, id string) (*v1.Pick, error)
 	ListPicks(ctx context.Context, clubID string) ([]*v1.Pick, error)
+	// ListPicksForClubs lists the picks of several clubs in one lookup
+	ListPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.Pick, error)
+	// QueryPicks gets a page of the picks matching a query, and the token of the next page, which is empty on the last one
+	QueryPicks(ctx context.Context, query PickQuery) ([]*v1.Pick, string, error)
+	// DeletePick marks a pick deleted, so that it can be restored until it's purged
 	DeletePick(ctx context.Context, id string) error
+	// ListDeletedPicks lists a user's deleted picks in a club that hasn't been deleted, with their deleted_at set
+	ListDeletedPicks(ctx context.Context, clubID string, userID string) ([]*v1.Pick, error)
+	RestorePick(ctx context.Context, id string) error
 
 	CreateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error
 	GetScheduledPick(ctx context.Context, id string) (*v1.ScheduledPick, error)
 	ListScheduledPicks(ctx context.Context, clubID string) ([]*v1.ScheduledPick, error)
+	// ListScheduledPicksForClubs lists the schedules of several clubs in one lookup, ordered by club ID then sequence number
+	ListScheduledPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.ScheduledPick, error)
+	// QueryScheduledPicks gets a page of the scheduled picks matching a query, and the token of the next page, which is
+	// empty on the last one
+	QueryScheduledPicks(ctx context.Context, query ScheduledPickQuery) ([]*v1.ScheduledPick, string, error)
+	UpdateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error
 	DeleteScheduledPick(ctx context.Context, id string) error
+	// ResetSchedule deletes a club's scheduled picks and saves the club together, so that either both happen or
+	// neither does, and returns the scheduled picks it deleted in sequence order
+	ResetSchedule(ctx context.Context, club *v1.Club) ([]*v1.ScheduledPick, error)
+
+	GetNotificationPreferences(ctx context.Context, userID string) (*v1.NotificationPreferences, error)
+	PutNotificationPreferences(ctx context.Context, prefs *v1.NotificationPreferences) error
+
+	GetCalendarFeed(ctx context.Context, userID string) (*v1.CalendarFeed, error)
+	GetCalendarFeedByToken(ctx context.Context, token string) (*v1.CalendarFeed, error)
+	PutCalendarFeed(ctx context.Context, feed *v1.CalendarFeed) error
+
+	CreateAppPassword(ctx context.Context, appPassword *v1.AppPassword) error
+	ListAppPasswords(ctx context.Context, userID string) ([]*v1.AppPassword, error)
+	PutAppPassword(ctx context.Context, appPassword *v1.AppPassword) error
+	DeleteAppPassword(ctx context.Context, id string) error
+
+	// PurgeDeleted permanently deletes the clubs and picks that were deleted before a time, and returns how many
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error)
+
+	// CheckConsistency finds records that refer to records that don't exist, which older versions could leave
+	// behind, and deletes them if repair is set. Orphans are returned in the order they're repaired.
+	CheckConsistency(ctx context.Context, repair bool) ([]Orphan, error)
+}
+
+// Backuper is implemented by storage that can copy itself while it's in use
+type Backuper interface {
+	// Backup writes a consistent copy of the database to a new file
+	Backup(ctx context.Context, path string) error
+}
+
+// BackupCloser is storage that's only opened to back it up, and must be closed afterwards
+type BackupCloser interface {
+	Backuper
+	io.Closer
+}
+
+// Encrypter is implemented by storage that can encrypt personal data at rest, which is currently users' email addresses
+type Encrypter interface {
+	// SetKeyring sets the keys that new and updated records are encrypted with, before the storage is used
+	SetKeyring(keys *keyring.Keyring)
+	// RotateKeys re-encrypts every field that isn't encrypted with the keyring's current key, including those that
+	// aren't encrypted at all, and returns how many records it rewrote
+	RotateKeys(ctx context.Context) (int, error)
+}
+
+// UseKeyring encrypts storage with the keys, if there are any
+func UseKeyring(s Storage, keys *keyring.Keyring) error {
+	if keys == nil {
+		return nil
+	}
+	encrypter, ok := s.(Encrypter)
+	if !ok {
+		return fmt.Errorf("storage doesn't support encryption")
+	}
+	encrypter.SetKeyring(keys)
+	return nil
+}
+
+// Orphan is a record that refers to a record that doesn't exist
+type Orphan struct {
+	// Kind of record, e.g. "pick"
+	Kind string
+	// ID of the record; records without their own ID are identified by the IDs they join, e.g. "<club>/<user>"
+	ID string
+	// Reason it's an orphan, e.g. "its club doesn't exist"
+	Reason string
+}
+
+func (o Orphan) String() string {
+	return o.Kind + " " + o.ID + ": " + o.Reason
 }
@@ -1,29 +1,62 @@
 package storage
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NewMemoryStorage creates a new in-memory storage implementation
 func NewMemoryStorage() Storage {
 	return &memoryStorage{
-		users:          make(map[string]*v1.User),
-		clubs:          make(map[string]*v1.Club),
-		picks:          make(map[string]*v1.Pick),
-		scheduledPicks: make(map[string]*v1.ScheduledPick),
+		users:                   make(map[string]*v1.User),
+		clubs:                   make(map[string]*v1.Club),
+		picks:                   make(map[string]*v1.Pick),
+		scheduledPicks:          make(map[string]*v1.ScheduledPick),
+		notificationPreferences: make(map[string]*v1.NotificationPreferences),
+		calendarFeeds:           make(map[string]*v1.CalendarFeed),
+		appPasswords:            make(map[string]*v1.AppPassword),
 	}
 }
 
+// memoryStorage keeps its own copies of everything it stores, so callers can't change stored data
+// without saving it, and enforces the same references as the SQL schema's foreign keys
 type memoryStorage struct {
-	mu             sync.RWMutex
-	users          map[string]*v1.User
-	clubs          map[string]*v1.Club
-	picks          map[string]*v1.Pick
-	scheduledPicks map[string]*v1.ScheduledPick
+	mu                      sync.RWMutex
+	users                   map[string]*v1.User
+	clubs                   map[string]*v1.Club
+	picks                   map[string]*v1.Pick
+	scheduledPicks          map[string]*v1.ScheduledPick
+	notificationPreferences map[string]*v1.NotificationPreferences
+	calendarFeeds           map[string]*v1.CalendarFeed
+	appPasswords            map[string]*v1.AppPassword
+}
+
+func clone[T proto.Message](message T) T {
+	return proto.Clone(message).(T)
+}
+
+// compareCreated orders by creation time, unset first, then by ID
+func compareCreated(aCreatedAt *timestamppb.Timestamp, aID string, bCreatedAt *timestamppb.Timestamp, bID string) int {
+	switch {
+	case aCreatedAt == nil && bCreatedAt != nil:
+		return -1
+	case aCreatedAt != nil && bCreatedAt == nil:
+		return 1
+	case aCreatedAt != nil && bCreatedAt != nil:
+		if c := aCreatedAt.AsTime().Compare(bCreatedAt.AsTime()); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(aID, bID)
 }
 
 // User operations
@@ -33,9 +66,14 @@ func (m *memoryStorage) CreateUser(ctx context.Context, user *v1.User) error {
 	defer m.mu.Unlock()
 
 	if _, exists := m.users[user.Id]; exists {
-		return fmt.Errorf("user already exists: %s", user.Id)
+		return alreadyExists("user", user.Id)
+	}
+	for _, existing := range m.users {
+		if existing.Email == user.Email {
+			return newError(ErrAlreadyExists, "user %s with email: %s", ErrAlreadyExists, user.Email)
+		}
 	}
-	m.users[user.Id] = user
+	m.users[user.Id] = clone(user)
 	return nil
 }
 
@@ -45,9 +83,9 @@ func (m *memoryStorage) GetUser(ctx context.Context, id string) (*v1.User, error
 
 	user, ok := m.users[id]
 	if !ok {
-		return nil, fmt.Errorf("user not found: %s", id)
+		return nil, notFound("user", id)
 	}
-	return user, nil
+	return clone(user), nil
 }
 
 func (m *memoryStorage) GetUserByEmail(ctx context.Context, email string) (*v1.User, error) {
@@ -56,10 +94,10 @@ func (m *memoryStorage) GetUserByEmail(ctx context.Context, email string) (*v1.U
 
 	for _, user := range m.users {
 		if user.Email == email {
-			return user, nil
+			return clone(user), nil
 		}
 	}
-	return nil, fmt.Errorf("user not found with email: %s", email)
+	return nil, newError(ErrNotFound, "user %s with email: %s", ErrNotFound, email)
 }
 
 func (m *memoryStorage) ListUsers(ctx context.Context) ([]*v1.User, error) {
@@ -68,7 +106,25 @@ func (m *memoryStorage) ListUsers(ctx context.Context) ([]*v1.User, error) {
 
 	users := make([]*v1.User, 0, len(m.users))
 	for _, user := range m.users {
-		users = append(users, user)
+		users = append(users, clone(user))
+	}
+	slices.SortFunc(users, func(a, b *v1.User) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
+	return users, nil
+}
+
+func (m *memoryStorage) GetUsers(ctx context.Context, ids []string) ([]*v1.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	users := make([]*v1.User, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if user, ok := m.users[id]; ok && !seen[id] {
+			users = append(users, clone(user))
+			seen[id] = true
+		}
 	}
 	return users, nil
 }
@@ -78,7 +134,20 @@ func (m *memoryStorage) DeleteUser(ctx context.Context, id string) error {
 	defer m.mu.Unlock()
 
 	if _, ok := m.users[id]; !ok {
-		return fmt.Errorf("user not found: %s", id)
+		return notFound("user", id)
+	}
+
+	// Everything that belongs to the user goes with them
+	m.deletePicks(func(pick *v1.Pick) bool { return pick.UserId == id })
+	for _, club := range m.clubs {
+		club.MemberIds = slices.DeleteFunc(club.MemberIds, func(memberID string) bool { return memberID == id })
+	}
+	delete(m.notificationPreferences, id)
+	delete(m.calendarFeeds, id)
+	for appPasswordID, appPassword := range m.appPasswords {
+		if appPassword.UserId == id {
+			delete(m.appPasswords, appPasswordID)
+		}
 	}
 	delete(m.users, id)
 	return nil
@@ -86,13 +155,40 @@ func (m *memoryStorage) DeleteUser(ctx context.Context, id string) error {
 
 // Club operations
 
+// checkMembers checks that a club's members exist and are listed once
+func (m *memoryStorage) checkMembers(club *v1.Club) error {
+	for i, memberID := range club.MemberIds {
+		if _, ok := m.users[memberID]; !ok {
+			return conflict("user doesn't exist: %s", memberID)
+		}
+		if slices.Contains(club.MemberIds[:i], memberID) {
+			return alreadyExists("club member", memberID)
+		}
+	}
+	return nil
+}
+
+// visibleClub returns a club unless it doesn't exist or has been deleted
+func (m *memoryStorage) visibleClub(id string) (*v1.Club, bool) {
+	club, ok := m.clubs[id]
+	if !ok || club.DeletedAt != nil {
+		return nil, false
+	}
+	return club, true
+}
+
 func (m *memoryStorage) CreateClub(ctx context.Context, club *v1.Club) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	if _, exists := m.clubs[club.Id]; exists {
-		return fmt.Errorf("club already exists: %s", club.Id)
+		return alreadyExists("club", club.Id)
 	}
+	if err := m.checkMembers(club); err != nil {
+		return err
+	}
+	club = clone(club)
+	club.DeletedAt = nil
 	m.clubs[club.Id] = club
 	return nil
 }
@@ -101,52 +197,130 @@ func (m *memoryStorage) GetClub(ctx context.Context, id string) (*v1.Club, error
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	club, ok := m.clubs[id]
+	club, ok := m.visibleClub(id)
 	if !ok {
-		return nil, fmt.Errorf("club not found: %s", id)
+		return nil, notFound("club", id)
 	}
-	return club, nil
+	return clone(club), nil
 }
 
 func (m *memoryStorage) ListClubs(ctx context.Context) ([]*v1.Club, error) {
+	return m.listClubs(func(club *v1.Club) bool { return true }), nil
+}
+
+func (m *memoryStorage) ListClubsForUser(ctx context.Context, userID string) ([]*v1.Club, error) {
+	return m.listClubs(func(club *v1.Club) bool { return slices.Contains(club.MemberIds, userID) }), nil
+}
+
+func (m *memoryStorage) QueryClubs(ctx context.Context, query ClubQuery) ([]*v1.Club, string, error) {
+	clubs := m.listClubs(func(club *v1.Club) bool {
+		return (query.MemberID == "" || slices.Contains(club.MemberIds, query.MemberID)) &&
+			(query.Started == nil || club.Started == *query.Started)
+	})
+	return paginate(clubs, query.Page, query.fingerprint(), clubKey)
+}
+
+func (m *memoryStorage) listClubs(match func(club *v1.Club) bool) []*v1.Club {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	clubs := make([]*v1.Club, 0, len(m.clubs))
+	clubs := make([]*v1.Club, 0)
 	for _, club := range m.clubs {
-		clubs = append(clubs, club)
+		if club.DeletedAt == nil && match(club) {
+			clubs = append(clubs, clone(club))
+		}
 	}
-	return clubs, nil
+	slices.SortFunc(clubs, func(a, b *v1.Club) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
+	return clubs
 }
 
-func (m *memoryStorage) ListClubsForUser(ctx context.Context, userID string) ([]*v1.Club, error) {
+func (m *memoryStorage) UpdateClub(ctx context.Context, club *v1.Club) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visibleClub(club.Id); !ok {
+		return notFound("club", club.Id)
+	}
+	if err := m.checkMembers(club); err != nil {
+		return err
+	}
+	club = clone(club)
+	club.DeletedAt = nil
+	m.clubs[club.Id] = club
+	return nil
+}
+
+func (m *memoryStorage) DeleteClub(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	club, ok := m.visibleClub(id)
+	if !ok {
+		return notFound("club", id)
+	}
+	club.DeletedAt = timestamppb.Now()
+	return nil
+}
+
+func (m *memoryStorage) ListDeletedClubs(ctx context.Context, userID string) ([]*v1.Club, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	clubs := make([]*v1.Club, 0)
 	for _, club := range m.clubs {
-		// Check if user is a member of this club
-		for _, memberID := range club.MemberIds {
-			if memberID == userID {
-				clubs = append(clubs, club)
-				break
-			}
+		if club.DeletedAt != nil && slices.Contains(club.MemberIds, userID) {
+			clubs = append(clubs, clone(club))
 		}
 	}
+	slices.SortFunc(clubs, func(a, b *v1.Club) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
 	return clubs, nil
 }
 
-func (m *memoryStorage) DeleteClub(ctx context.Context, id string) error {
+func (m *memoryStorage) RestoreClub(ctx context.Context, id string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, ok := m.clubs[id]; !ok {
-		return fmt.Errorf("club not found: %s", id)
+	club, ok := m.clubs[id]
+	if !ok || club.DeletedAt == nil {
+		return notFound("deleted club", id)
 	}
-	delete(m.clubs, id)
+	club.DeletedAt = nil
 	return nil
 }
 
+// purgeClub deletes a club, its picks, and its schedule
+func (m *memoryStorage) purgeClub(id string) {
+	m.deletePicks(func(pick *v1.Pick) bool { return pick.ClubId == id })
+	for assignmentID, assignment := range m.scheduledPicks {
+		if assignment.ClubId == id {
+			delete(m.scheduledPicks, assignmentID)
+		}
+	}
+	delete(m.clubs, id)
+}
+
+// deletePicks deletes the matching picks, along with their scheduled picks, and returns how many were deleted
+func (m *memoryStorage) deletePicks(match func(pick *v1.Pick) bool) int {
+	deleted := 0
+	for pickID, pick := range m.picks {
+		if !match(pick) {
+			continue
+		}
+		for assignmentID, assignment := range m.scheduledPicks {
+			if assignment.Pick.Id == pickID {
+				delete(m.scheduledPicks, assignmentID)
+			}
+		}
+		delete(m.picks, pickID)
+		deleted++
+	}
+	return deleted
+}
+
 // Pick operations
 
 func (m *memoryStorage) CreatePick(ctx context.Context, pick *v1.Pick) error {
@@ -154,91 +328,513 @@ func (m *memoryStorage) CreatePick(ctx context.Context, pick *v1.Pick) error {
 	defer m.mu.Unlock()
 
 	if _, exists := m.picks[pick.Id]; exists {
-		return fmt.Errorf("pick already exists: %s", pick.Id)
+		return alreadyExists("pick", pick.Id)
 	}
+	if _, ok := m.visibleClub(pick.ClubId); !ok {
+		return conflict("club doesn't exist: %s", pick.ClubId)
+	}
+	if _, ok := m.users[pick.UserId]; !ok {
+		return conflict("user doesn't exist: %s", pick.UserId)
+	}
+	pick = clone(pick)
+	pick.DeletedAt = nil
 	m.picks[pick.Id] = pick
 	return nil
 }
 
+// visiblePick returns a pick unless it doesn't exist, or it or its club has been deleted
+func (m *memoryStorage) visiblePick(id string) (*v1.Pick, bool) {
+	pick, ok := m.picks[id]
+	if !ok || pick.DeletedAt != nil {
+		return nil, false
+	}
+	if _, ok := m.visibleClub(pick.ClubId); !ok {
+		return nil, false
+	}
+	return pick, true
+}
+
 func (m *memoryStorage) GetPick(ctx context.Context, id string) (*v1.Pick, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	pick, ok := m.picks[id]
+	pick, ok := m.visiblePick(id)
 	if !ok {
-		return nil, fmt.Errorf("pick not found: %s", id)
+		return nil, notFound("pick", id)
 	}
-	return pick, nil
+	return clone(pick), nil
 }
 
 func (m *memoryStorage) ListPicks(ctx context.Context, clubID string) ([]*v1.Pick, error) {
+	return m.ListPicksForClubs(ctx, []string{clubID})
+}
+
+func (m *memoryStorage) ListPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.Pick, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	picks := make([]*v1.Pick, 0)
 	for _, pick := range m.picks {
-		if pick.ClubId == clubID {
-			picks = append(picks, pick)
+		if _, ok := m.visiblePick(pick.Id); ok && slices.Contains(clubIDs, pick.ClubId) {
+			picks = append(picks, clone(pick))
 		}
 	}
+	slices.SortFunc(picks, func(a, b *v1.Pick) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
 	return picks, nil
 }
 
+func (m *memoryStorage) QueryPicks(ctx context.Context, query PickQuery) ([]*v1.Pick, string, error) {
+	picks, err := m.ListPicks(ctx, query.ClubID)
+	if err != nil {
+		return nil, "", err
+	}
+	if query.UserID != "" {
+		picks = slices.DeleteFunc(picks, func(pick *v1.Pick) bool { return pick.UserId != query.UserID })
+	}
+	return paginate(picks, query.Page, query.fingerprint(), pickKey)
+}
+
 func (m *memoryStorage) DeletePick(ctx context.Context, id string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, ok := m.picks[id]; !ok {
-		return fmt.Errorf("pick not found: %s", id)
+	pick, ok := m.visiblePick(id)
+	if !ok {
+		return notFound("pick", id)
+	}
+	for _, assignment := range m.scheduledPicks {
+		if _, ok := m.visibleClub(assignment.ClubId); ok && assignment.Pick.Id == id {
+			return conflict("pick is scheduled: %s", id)
+		}
+	}
+	pick.DeletedAt = timestamppb.Now()
+	return nil
+}
+
+func (m *memoryStorage) ListDeletedPicks(ctx context.Context, clubID string, userID string) ([]*v1.Pick, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	picks := make([]*v1.Pick, 0)
+	if _, ok := m.visibleClub(clubID); !ok {
+		return picks, nil
+	}
+	for _, pick := range m.picks {
+		if pick.DeletedAt != nil && pick.ClubId == clubID && pick.UserId == userID {
+			picks = append(picks, clone(pick))
+		}
+	}
+	slices.SortFunc(picks, func(a, b *v1.Pick) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
+	return picks, nil
+}
+
+func (m *memoryStorage) RestorePick(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pick, ok := m.picks[id]
+	if !ok || pick.DeletedAt == nil {
+		return notFound("deleted pick", id)
+	}
+	// the picks of a deleted club are restored with it
+	if _, ok := m.visibleClub(pick.ClubId); !ok {
+		return notFound("deleted pick", id)
 	}
-	delete(m.picks, id)
+	pick.DeletedAt = nil
 	return nil
 }
 
 // ScheduledPick operations
 
+// checkScheduledPick checks that a scheduled pick's club and pick exist, and haven't been deleted
+func (m *memoryStorage) checkScheduledPick(assignment *v1.ScheduledPick) error {
+	if assignment.Pick == nil {
+		return fmt.Errorf("scheduled pick has no pick: %s", assignment.Id)
+	}
+	if _, ok := m.visibleClub(assignment.ClubId); !ok {
+		return conflict("club doesn't exist: %s", assignment.ClubId)
+	}
+	if _, ok := m.visiblePick(assignment.Pick.Id); !ok {
+		return conflict("pick doesn't exist: %s", assignment.Pick.Id)
+	}
+	return nil
+}
+
+// scheduledPick copies a stored scheduled pick with the current version of its pick, like the SQL backends' join
+func (m *memoryStorage) scheduledPick(assignment *v1.ScheduledPick) *v1.ScheduledPick {
+	assignment = clone(assignment)
+	assignment.Pick = clone(m.picks[assignment.Pick.Id])
+	return assignment
+}
+
 func (m *memoryStorage) CreateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	if _, exists := m.scheduledPicks[assignment.Id]; exists {
-		return fmt.Errorf("scheduled pick already exists: %s", assignment.Id)
+		return alreadyExists("scheduled pick", assignment.Id)
+	}
+	if err := m.checkScheduledPick(assignment); err != nil {
+		return err
 	}
-	m.scheduledPicks[assignment.Id] = assignment
+	m.scheduledPicks[assignment.Id] = clone(assignment)
 	return nil
 }
 
+// scheduledPickVisible reports whether a scheduled pick exists and its club hasn't been deleted
+func (m *memoryStorage) scheduledPickVisible(id string) bool {
+	assignment, ok := m.scheduledPicks[id]
+	if !ok {
+		return false
+	}
+	_, ok = m.visibleClub(assignment.ClubId)
+	return ok
+}
+
 func (m *memoryStorage) GetScheduledPick(ctx context.Context, id string) (*v1.ScheduledPick, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	assignment, ok := m.scheduledPicks[id]
-	if !ok {
-		return nil, fmt.Errorf("scheduled pick not found: %s", id)
+	if !m.scheduledPickVisible(id) {
+		return nil, notFound("scheduled pick", id)
 	}
-	return assignment, nil
+	return m.scheduledPick(m.scheduledPicks[id]), nil
 }
 
 func (m *memoryStorage) ListScheduledPicks(ctx context.Context, clubID string) ([]*v1.ScheduledPick, error) {
+	return m.ListScheduledPicksForClubs(ctx, []string{clubID})
+}
+
+func (m *memoryStorage) ListScheduledPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.ScheduledPick, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	assignments := make([]*v1.ScheduledPick, 0)
 	for _, assignment := range m.scheduledPicks {
-		if assignment.ClubId == clubID {
-			assignments = append(assignments, assignment)
+		if m.scheduledPickVisible(assignment.Id) && slices.Contains(clubIDs, assignment.ClubId) {
+			assignments = append(assignments, m.scheduledPick(assignment))
 		}
 	}
+	slices.SortFunc(assignments, func(a, b *v1.ScheduledPick) int {
+		return cmp.Or(
+			strings.Compare(a.ClubId, b.ClubId),
+			cmp.Compare(a.SequenceNumber, b.SequenceNumber),
+			strings.Compare(a.Id, b.Id),
+		)
+	})
 	return assignments, nil
 }
 
+func (m *memoryStorage) QueryScheduledPicks(ctx context.Context, query ScheduledPickQuery) ([]*v1.ScheduledPick, string, error) {
+	query, _, err := query.pinned()
+	if err != nil {
+		return nil, "", err
+	}
+	assignments, err := m.ListScheduledPicks(ctx, query.ClubID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !query.StartingFrom.IsZero() {
+		assignments = slices.DeleteFunc(assignments, func(assignment *v1.ScheduledPick) bool {
+			return assignment.StartDate == nil || assignment.StartDate.AsTime().Before(query.StartingFrom)
+		})
+	}
+	return paginate(assignments, query.Page, query.fingerprint(), query.key)
+}
+
+func (m *memoryStorage) UpdateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.scheduledPickVisible(assignment.Id) {
+		return notFound("scheduled pick", assignment.Id)
+	}
+	if err := m.checkScheduledPick(assignment); err != nil {
+		return err
+	}
+	m.scheduledPicks[assignment.Id] = clone(assignment)
+	return nil
+}
+
 func (m *memoryStorage) DeleteScheduledPick(ctx context.Context, id string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, ok := m.scheduledPicks[id]; !ok {
-		return fmt.Errorf("scheduled pick not found: %s", id)
+	if !m.scheduledPickVisible(id) {
+		return notFound("scheduled pick", id)
 	}
 	delete(m.scheduledPicks, id)
 	return nil
 }
+
+func (m *memoryStorage) ResetSchedule(ctx context.Context, club *v1.Club) ([]*v1.ScheduledPick, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visibleClub(club.Id); !ok {
+		return nil, notFound("club", club.Id)
+	}
+	if err := m.checkMembers(club); err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*v1.ScheduledPick, 0)
+	for id, assignment := range m.scheduledPicks {
+		if assignment.ClubId == club.Id {
+			assignments = append(assignments, m.scheduledPick(assignment))
+			delete(m.scheduledPicks, id)
+		}
+	}
+	slices.SortFunc(assignments, func(a, b *v1.ScheduledPick) int {
+		return cmp.Or(cmp.Compare(a.SequenceNumber, b.SequenceNumber), strings.Compare(a.Id, b.Id))
+	})
+
+	club = clone(club)
+	club.DeletedAt = nil
+	m.clubs[club.Id] = club
+	return assignments, nil
+}
+
+func (m *memoryStorage) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	purged := 0
+	for id, club := range m.clubs {
+		if club.DeletedAt != nil && club.DeletedAt.AsTime().Before(deletedBefore) {
+			m.purgeClub(id)
+			purged++
+		}
+	}
+	purged += m.deletePicks(func(pick *v1.Pick) bool {
+		return pick.DeletedAt != nil && pick.DeletedAt.AsTime().Before(deletedBefore)
+	})
+	return purged, nil
+}
+
+// NotificationPreferences operations
+
+func (m *memoryStorage) GetNotificationPreferences(ctx context.Context, userID string) (*v1.NotificationPreferences, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	prefs, ok := m.notificationPreferences[userID]
+	if !ok {
+		return nil, notFound("notification preferences", userID)
+	}
+	return clone(prefs), nil
+}
+
+func (m *memoryStorage) PutNotificationPreferences(ctx context.Context, prefs *v1.NotificationPreferences) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[prefs.UserId]; !ok {
+		return conflict("user doesn't exist: %s", prefs.UserId)
+	}
+	for i, club := range prefs.Clubs {
+		for _, other := range prefs.Clubs[:i] {
+			if other.ClubId == club.ClubId {
+				return alreadyExists("club notification preferences", club.ClubId)
+			}
+		}
+	}
+	m.notificationPreferences[prefs.UserId] = clone(prefs)
+	return nil
+}
+
+// CalendarFeed operations
+
+func (m *memoryStorage) GetCalendarFeed(ctx context.Context, userID string) (*v1.CalendarFeed, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	feed, ok := m.calendarFeeds[userID]
+	if !ok {
+		return nil, notFound("calendar feed", userID)
+	}
+	return clone(feed), nil
+}
+
+func (m *memoryStorage) GetCalendarFeedByToken(ctx context.Context, token string) (*v1.CalendarFeed, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, feed := range m.calendarFeeds {
+		if feed.Token == token {
+			return clone(feed), nil
+		}
+	}
+	return nil, newError(ErrNotFound, "calendar feed %s", ErrNotFound)
+}
+
+func (m *memoryStorage) PutCalendarFeed(ctx context.Context, feed *v1.CalendarFeed) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[feed.UserId]; !ok {
+		return conflict("user doesn't exist: %s", feed.UserId)
+	}
+	for userID, other := range m.calendarFeeds {
+		if userID != feed.UserId && other.Token == feed.Token {
+			return newError(ErrAlreadyExists, "calendar feed %s with token", ErrAlreadyExists)
+		}
+	}
+	m.calendarFeeds[feed.UserId] = clone(feed)
+	return nil
+}
+
+// AppPassword operations
+
+func (m *memoryStorage) CreateAppPassword(ctx context.Context, appPassword *v1.AppPassword) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.appPasswords[appPassword.Id]; exists {
+		return alreadyExists("app password", appPassword.Id)
+	}
+	if _, ok := m.users[appPassword.UserId]; !ok {
+		return conflict("user doesn't exist: %s", appPassword.UserId)
+	}
+	m.appPasswords[appPassword.Id] = clone(appPassword)
+	return nil
+}
+
+func (m *memoryStorage) ListAppPasswords(ctx context.Context, userID string) ([]*v1.AppPassword, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	appPasswords := make([]*v1.AppPassword, 0)
+	for _, appPassword := range m.appPasswords {
+		if appPassword.UserId == userID {
+			appPasswords = append(appPasswords, clone(appPassword))
+		}
+	}
+	slices.SortFunc(appPasswords, func(a, b *v1.AppPassword) int {
+		return compareCreated(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
+	return appPasswords, nil
+}
+
+func (m *memoryStorage) PutAppPassword(ctx context.Context, appPassword *v1.AppPassword) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[appPassword.UserId]; !ok {
+		return conflict("user doesn't exist: %s", appPassword.UserId)
+	}
+	m.appPasswords[appPassword.Id] = clone(appPassword)
+	return nil
+}
+
+func (m *memoryStorage) DeleteAppPassword(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.appPasswords[id]; !exists {
+		return notFound("app password", id)
+	}
+	delete(m.appPasswords, id)
+	return nil
+}
+
+// Consistency operations
+
+func (m *memoryStorage) CheckConsistency(ctx context.Context, repair bool) ([]Orphan, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var orphans []Orphan
+	found := func(kind string, ids []string, reason string) {
+		slices.Sort(ids)
+		for _, id := range ids {
+			orphans = append(orphans, Orphan{Kind: kind, ID: id, Reason: reason})
+		}
+	}
+
+	orphanPicks := make(map[string]bool)
+	for id, pick := range m.picks {
+		_, clubExists := m.clubs[pick.ClubId]
+		_, userExists := m.users[pick.UserId]
+		if !clubExists || !userExists {
+			orphanPicks[id] = true
+		}
+	}
+
+	var ids []string
+	for id, assignment := range m.scheduledPicks {
+		_, clubExists := m.clubs[assignment.ClubId]
+		_, pickExists := m.picks[assignment.Pick.Id]
+		if !clubExists || !pickExists || orphanPicks[assignment.Pick.Id] {
+			ids = append(ids, id)
+			if repair {
+				delete(m.scheduledPicks, id)
+			}
+		}
+	}
+	found("scheduled pick", ids, "its club or pick doesn't exist")
+
+	ids = nil
+	for id := range orphanPicks {
+		ids = append(ids, id)
+		if repair {
+			delete(m.picks, id)
+		}
+	}
+	found("pick", ids, "its club or user doesn't exist")
+
+	ids = nil
+	for clubID, club := range m.clubs {
+		for _, memberID := range club.MemberIds {
+			if _, ok := m.users[memberID]; !ok {
+				ids = append(ids, clubID+"/"+memberID)
+			}
+		}
+		if repair {
+			club.MemberIds = slices.DeleteFunc(club.MemberIds, func(memberID string) bool {
+				_, ok := m.users[memberID]
+				return !ok
+			})
+		}
+	}
+	found("club member", ids, "its user doesn't exist")
+
+	ids = nil
+	for userID := range m.notificationPreferences {
+		if _, ok := m.users[userID]; !ok {
+			ids = append(ids, userID)
+			if repair {
+				delete(m.notificationPreferences, userID)
+			}
+		}
+	}
+	found("notification preferences", ids, "its user doesn't exist")
+
+	ids = nil
+	for userID := range m.calendarFeeds {
+		if _, ok := m.users[userID]; !ok {
+			ids = append(ids, userID)
+			if repair {
+				delete(m.calendarFeeds, userID)
+			}
+		}
+	}
+	found("calendar feed", ids, "its user doesn't exist")
+
+	ids = nil
+	for id, appPassword := range m.appPasswords {
+		if _, ok := m.users[appPassword.UserId]; !ok {
+			ids = append(ids, id)
+			if repair {
+				delete(m.appPasswords, id)
+			}
+		}
+	}
+	found("app password", ids, "its user doesn't exist")
+
+	return orphans, nil
+}
@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// memorySnapshotVersion is the version of the snapshot format, which is incremented when older versions can't load it
+const memorySnapshotVersion = 1
+
+// memorySnapshot is the file format of memory storage snapshots.
+// Records are protojson, so that snapshots can be loaded after fields are added.
+type memorySnapshot struct {
+	Version                 int               `json:"version"`
+	Users                   []json.RawMessage `json:"users"`
+	Clubs                   []json.RawMessage `json:"clubs"`
+	Picks                   []json.RawMessage `json:"picks"`
+	ScheduledPicks          []json.RawMessage `json:"scheduled_picks"`
+	NotificationPreferences []json.RawMessage `json:"notification_preferences"`
+	CalendarFeeds           []json.RawMessage `json:"calendar_feeds"`
+	AppPasswords            []json.RawMessage `json:"app_passwords"`
+}
+
+// NewSnapshotMemoryStorage creates in-memory storage that's loaded from a snapshot file, if there is one, and saved to
+// it every interval (unless the interval is zero) and when it's closed. Snapshots are replaced atomically, so a crash
+// loses at most the changes since the last one.
+func NewSnapshotMemoryStorage(path string, interval time.Duration) (Storage, error) {
+	if interval < 0 {
+		return nil, fmt.Errorf("snapshot interval must not be negative: %s", interval)
+	}
+	s := &snapshotStorage{
+		memoryStorage: NewMemoryStorage().(*memoryStorage),
+		path:          path,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	default:
+		if err := s.load(data); err != nil {
+			return nil, fmt.Errorf("failed to load snapshot %s: %w", path, err)
+		}
+		s.saved = data
+	}
+
+	// save right away, so that a path that can't be written fails now rather than on shutdown
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+
+	go s.run(interval)
+	return s, nil
+}
+
+// snapshotStorage is memory storage that's saved to a snapshot file
+type snapshotStorage struct {
+	*memoryStorage
+	path string
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// saveMu serializes saves, and saved is the last snapshot saved, so that unchanged storage isn't saved again
+	saveMu sync.Mutex
+	saved  []byte
+}
+
+// run saves a snapshot every interval until the storage is closed
+func (s *snapshotStorage) run(interval time.Duration) {
+	defer close(s.done)
+	if interval == 0 {
+		<-s.stop
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+		if err := s.save(); err != nil {
+			zap.L().Error("Failed to save memory storage snapshot", zap.String("path", s.path), zap.Error(err))
+		}
+	}
+}
+
+// Close stops saving snapshots periodically, and saves a final one
+func (s *snapshotStorage) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		err = s.save()
+	})
+	return err
+}
+
+// save writes a snapshot to a temporary file and renames it over the previous one, unless nothing has changed
+func (s *snapshotStorage) save() error {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	data, err := s.snapshot()
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if bytes.Equal(data, s.saved) {
+		return nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+
+	s.saved = data
+	return nil
+}
+
+// snapshot encodes every record, in ID order so that unchanged storage encodes the same way
+func (s *snapshotStorage) snapshot() ([]byte, error) {
+	m := s.memoryStorage
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	snapshot := memorySnapshot{Version: memorySnapshotVersion}
+	var err error
+	encode := func(records *[]json.RawMessage, message proto.Message) {
+		if err != nil {
+			return
+		}
+		var data []byte
+		data, err = protojson.Marshal(message)
+		*records = append(*records, data)
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.users)) {
+		encode(&snapshot.Users, m.users[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.clubs)) {
+		encode(&snapshot.Clubs, m.clubs[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.picks)) {
+		encode(&snapshot.Picks, m.picks[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.scheduledPicks)) {
+		encode(&snapshot.ScheduledPicks, m.scheduledPicks[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.notificationPreferences)) {
+		encode(&snapshot.NotificationPreferences, m.notificationPreferences[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.calendarFeeds)) {
+		encode(&snapshot.CalendarFeeds, m.calendarFeeds[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(m.appPasswords)) {
+		encode(&snapshot.AppPasswords, m.appPasswords[id])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(snapshot)
+}
+
+// load replaces the storage's records with a snapshot's
+func (s *snapshotStorage) load(data []byte) error {
+	var snapshot memorySnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return err
+	}
+	if snapshot.Version > memorySnapshotVersion {
+		return fmt.Errorf("snapshot version %d is newer than this version of watchclub supports (%d)", snapshot.Version, memorySnapshotVersion)
+	}
+
+	m := s.memoryStorage
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return errors.Join(
+		decodeRecords(snapshot.Users, m.users, func(user *v1.User) string { return user.Id }),
+		decodeRecords(snapshot.Clubs, m.clubs, func(club *v1.Club) string { return club.Id }),
+		decodeRecords(snapshot.Picks, m.picks, func(pick *v1.Pick) string { return pick.Id }),
+		decodeRecords(snapshot.ScheduledPicks, m.scheduledPicks, func(assignment *v1.ScheduledPick) string { return assignment.Id }),
+		decodeRecords(snapshot.NotificationPreferences, m.notificationPreferences, func(prefs *v1.NotificationPreferences) string { return prefs.UserId }),
+		decodeRecords(snapshot.CalendarFeeds, m.calendarFeeds, func(feed *v1.CalendarFeed) string { return feed.UserId }),
+		decodeRecords(snapshot.AppPasswords, m.appPasswords, func(appPassword *v1.AppPassword) string { return appPassword.Id }),
+	)
+}
+
+func decodeRecords[T proto.Message](records []json.RawMessage, into map[string]T, key func(T) string) error {
+	for _, data := range records {
+		var record T
+		record = record.ProtoReflect().Type().New().Interface().(T)
+		if err := protojson.Unmarshal(data, record); err != nil {
+			return err
+		}
+		into[key(record)] = record
+	}
+	return nil
+}
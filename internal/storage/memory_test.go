@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+func Test_Memory_Conformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		return NewMemoryStorage()
+	})
+}
+
+func Test_Memory_SnapshotConformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		s, err := NewSnapshotMemoryStorage(filepath.Join(t.TempDir(), "snapshot.json"), 0)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.(*snapshotStorage).Close() })
+		return s
+	})
+}
+
+func Test_Memory_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	s, err := NewStorage("memory?snapshot=" + path + "&interval=10ms")
+	require.NoError(t, err)
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}))
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "s1", ClubId: "club", SequenceNumber: 1, Pick: pick}))
+
+	// the interval saves changes without closing the storage
+	assert.Eventually(t, func() bool {
+		loaded, err := NewSnapshotMemoryStorage(path, 0)
+		if err != nil {
+			return false
+		}
+		defer loaded.(*snapshotStorage).Close()
+		_, err = loaded.GetScheduledPick(ctx, "s1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// closing saves changes made since the last interval
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	require.NoError(t, s.(*snapshotStorage).Close())
+	require.NoError(t, s.(*snapshotStorage).Close())
+
+	loaded, err := NewStorage("memory?snapshot=" + path + "&interval=0")
+	require.NoError(t, err)
+	defer loaded.(*snapshotStorage).Close()
+	user, err := loaded.GetUser(ctx, "sam")
+	require.NoError(t, err)
+	assert.Equal(t, "Sam", user.Name)
+	scheduled, err := loaded.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+	assert.Equal(t, "Heat", scheduled[0].Pick.Title)
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func Test_Memory_SnapshotErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corrupt, []byte("{"), 0o600))
+	_, err := NewStorage("memory?snapshot=" + corrupt)
+	assert.ErrorContains(t, err, "failed to load snapshot")
+
+	_, err = NewStorage("memory?snapshot=" + filepath.Join(dir, "missing", "snapshot.json"))
+	assert.ErrorContains(t, err, "failed to create snapshot")
+
+	_, err = NewStorage("memory?snapshot=" + filepath.Join(dir, "snapshot.json") + "&interval=soon")
+	assert.ErrorContains(t, err, "invalid memory storage snapshot interval")
+
+	_, err = NewStorage("memory?interval=1m")
+	assert.ErrorContains(t, err, "requires a snapshot path")
+
+	_, err = NewStorage("memory?path=" + filepath.Join(dir, "snapshot.json"))
+	assert.ErrorContains(t, err, "unsupported memory storage option: path")
+}
+
+func Test_Memory_CheckConsistency(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage().(*memoryStorage)
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}))
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "s1", ClubId: "club", SequenceNumber: 1, Pick: pick}))
+
+	// older versions didn't cascade deletes, which left these behind
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "gone", Title: "Alien"}
+	s.picks["alien"] = alien
+	s.scheduledPicks["s2"] = &v1.ScheduledPick{Id: "s2", ClubId: "club", SequenceNumber: 2, Pick: alien}
+	s.clubs["club"].MemberIds = append(s.clubs["club"].MemberIds, "gone")
+	s.notificationPreferences["gone"] = &v1.NotificationPreferences{UserId: "gone"}
+	s.appPasswords["pw"] = &v1.AppPassword{Id: "pw", UserId: "gone"}
+
+	expected := []Orphan{
+		{Kind: "scheduled pick", ID: "s2", Reason: "its club or pick doesn't exist"},
+		{Kind: "pick", ID: "alien", Reason: "its club or user doesn't exist"},
+		{Kind: "club member", ID: "club/gone", Reason: "its user doesn't exist"},
+		{Kind: "notification preferences", ID: "gone", Reason: "its user doesn't exist"},
+		{Kind: "app password", ID: "pw", Reason: "its user doesn't exist"},
+	}
+	orphans, err := s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, orphans)
+
+	orphans, err = s.CheckConsistency(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, expected, orphans)
+
+	orphans, err = s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+
+	club, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jo"}, club.MemberIds)
+	scheduled, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+	assert.Equal(t, "s1", scheduled[0].Id)
+}
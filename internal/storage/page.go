@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// Page selects part of a list, in the list's order
+type Page struct {
+	// Size is the most records to return; zero returns them all
+	Size int
+	// Token continues a list from the NextPageToken of its previous page; empty starts from the beginning
+	Token string
+}
+
+// ClubQuery selects clubs, ordered by creation time (unset first) then ID
+type ClubQuery struct {
+	// MemberID only selects clubs this user is a member of
+	MemberID string
+	// Started only selects clubs that have started, or haven't, if set
+	Started *bool
+	Page
+}
+
+// PickQuery selects a club's picks, ordered by creation time (unset first) then ID
+type PickQuery struct {
+	ClubID string
+	// UserID only selects picks made by this user
+	UserID string
+	Page
+}
+
+// ScheduledPickQuery selects a club's scheduled picks, ordered by sequence number then ID
+type ScheduledPickQuery struct {
+	ClubID string
+	// StartingFrom only selects scheduled picks that start at or after this time, if set.
+	// Later pages keep the first page's time, which is in their tokens, so it can be relative to the current time.
+	StartingFrom time.Time
+	Page
+}
+
+func (q ClubQuery) fingerprint() string {
+	started := "any"
+	if q.Started != nil {
+		started = fmt.Sprint(*q.Started)
+	}
+	return "clubs:" + q.MemberID + ":" + started
+}
+
+func (q PickQuery) fingerprint() string {
+	return "picks:" + q.ClubID + ":" + q.UserID
+}
+
+func (q ScheduledPickQuery) fingerprint() string {
+	return "scheduled-picks:" + q.ClubID + ":" + fmt.Sprint(!q.StartingFrom.IsZero())
+}
+
+// pinned returns the query with the StartingFrom of the first page, if it continues a list from a page token
+func (q ScheduledPickQuery) pinned() (ScheduledPickQuery, *pageKey, error) {
+	after, err := q.Page.after(q.fingerprint())
+	if err != nil {
+		return q, nil, err
+	}
+	if after != nil && after.StartingFrom != nil {
+		q.StartingFrom = time.Unix(0, *after.StartingFrom)
+	}
+	return q, after, nil
+}
+
+// key returns the page key of a scheduled pick, along with the query's StartingFrom for the pages that follow
+func (q ScheduledPickQuery) key(assignment *v1.ScheduledPick) pageKey {
+	key := pageKey{Sequence: assignment.SequenceNumber, ID: assignment.Id}
+	if !q.StartingFrom.IsZero() {
+		from := q.StartingFrom.UnixNano()
+		key.StartingFrom = &from
+	}
+	return key
+}
+
+// pageKey is the position of the last record of a page in its list's order.
+// Page tokens are encoded page keys, along with the query they belong to, so they can't be reused with another query.
+type pageKey struct {
+	Query     string `json:"q"`
+	CreatedAt *int64 `json:"c,omitempty"`
+	Sequence  int32  `json:"s,omitempty"`
+	ID        string `json:"i"`
+	// StartingFrom is the StartingFrom of a scheduled pick query
+	StartingFrom *int64 `json:"f,omitempty"`
+}
+
+func createdKey(createdAt *timestamppb.Timestamp, id string) pageKey {
+	key := pageKey{ID: id}
+	if createdAt != nil {
+		nanos := createdAt.AsTime().UnixNano()
+		key.CreatedAt = &nanos
+	}
+	return key
+}
+
+func clubKey(club *v1.Club) pageKey {
+	return createdKey(club.CreatedAt, club.Id)
+}
+
+func pickKey(pick *v1.Pick) pageKey {
+	return createdKey(pick.CreatedAt, pick.Id)
+}
+
+// compare orders page keys like their lists: unset creation times first, then by creation time, sequence number, and ID
+func (k pageKey) compare(other pageKey) int {
+	created := 0
+	switch {
+	case k.CreatedAt == nil && other.CreatedAt != nil:
+		created = -1
+	case k.CreatedAt != nil && other.CreatedAt == nil:
+		created = 1
+	case k.CreatedAt != nil:
+		created = cmp.Compare(*k.CreatedAt, *other.CreatedAt)
+	}
+	return cmp.Or(created, cmp.Compare(k.Sequence, other.Sequence), strings.Compare(k.ID, other.ID))
+}
+
+// after returns the key of the record that a page continues after, or nil for the first page
+func (p Page) after(query string) (*pageKey, error) {
+	if p.Token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(p.Token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var key pageKey
+	if err := json.Unmarshal(data, &key); err != nil || key.Query != query {
+		return nil, ErrInvalidPageToken
+	}
+	return &key, nil
+}
+
+// limit returns how many records to fetch for a page: one more than its size, to tell whether there's another page
+func (p Page) limit() int {
+	if p.Size <= 0 {
+		return 0
+	}
+	return p.Size + 1
+}
+
+// nextPage trims records fetched with the page's limit down to the page, and returns the token of the next page, if any
+func nextPage[T any](records []T, page Page, query string, key func(T) pageKey) ([]T, string) {
+	if page.Size <= 0 || len(records) <= page.Size {
+		return records, ""
+	}
+	records = records[:page.Size]
+	last := key(records[len(records)-1])
+	last.Query = query
+	data, _ := json.Marshal(last)
+	return records, base64.RawURLEncoding.EncodeToString(data)
+}
+
+// paginate returns a page of records that are already in order, along with the token of the next page, if any
+func paginate[T any](records []T, page Page, query string, key func(T) pageKey) ([]T, string, error) {
+	after, err := page.after(query)
+	if err != nil {
+		return nil, "", err
+	}
+	if after != nil {
+		start := len(records)
+		for i, record := range records {
+			if key(record).compare(*after) > 0 {
+				start = i
+				break
+			}
+		}
+		records = records[start:]
+	}
+	if limit := page.limit(); limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	records, next := nextPage(records, page, query, key)
+	return records, next, nil
+}
@@ -0,0 +1,186 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+var postgresMigrations = []sqlMigration{
+	{version: 1, name: "columns", up: execMigration(postgresColumnSchema)},
+	{version: 2, name: "email index", up: execMigration(emailIndexSchema)},
+	{version: 3, name: "soft delete", up: execMigration(postgresSoftDeleteSchema)},
+}
+
+// postgresSoftDeleteSchema adds the time clubs and picks were deleted, which is NULL until they are
+const postgresSoftDeleteSchema = `
+ALTER TABLE clubs ADD COLUMN deleted_at BIGINT;
+ALTER TABLE picks ADD COLUMN deleted_at BIGINT;
+`
+
+// postgresMigrationLock is the advisory lock that keeps servers starting at the same time from migrating concurrently
+const postgresMigrationLock = 0x77617463 // "watc"
+
+// migratePostgres applies the migrations that haven't been applied to the database yet
+func migratePostgres(ctx context.Context, db *sql.DB) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", postgresMigrationLock); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", postgresMigrationLock)
+
+	_, err = conn.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at BIGINT NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	var current int
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current); err != nil {
+		return fmt.Errorf("failed to query schema version: %w", err)
+	}
+	latest := postgresMigrations[len(postgresMigrations)-1].version
+	if current > latest {
+		return fmt.Errorf("database schema version %d is newer than the latest known version %d", current, latest)
+	}
+
+	for _, migration := range postgresMigrations {
+		if migration.version <= current {
+			continue
+		}
+		if err := applyPostgresMigration(ctx, conn, migration); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", migration.version, migration.name, err)
+		}
+	}
+
+	return nil
+}
+
+func applyPostgresMigration(ctx context.Context, conn *sql.Conn, migration sqlMigration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := migration.up(ctx, tx); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)",
+		migration.version, migration.name, time.Now().UnixNano())
+	if err != nil {
+		return fmt.Errorf("failed to record migration: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// postgresColumnSchema matches the SQLite schema. Timestamps are Unix nanoseconds rather than TIMESTAMPTZ,
+// which only has microsecond precision, so that they round trip exactly like the other backends.
+const postgresColumnSchema = `
+CREATE TABLE users (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	created_at BIGINT
+);
+
+CREATE TABLE clubs (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	start_date BIGINT,
+	started BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at BIGINT,
+	updated_at BIGINT,
+	max_picks_per_member INTEGER NOT NULL DEFAULT 0,
+	schedule_interval_quantity INTEGER NOT NULL DEFAULT 0,
+	schedule_interval_unit INTEGER NOT NULL DEFAULT 0
+);
+
+-- position keeps members in the order they joined
+CREATE TABLE club_members (
+	club_id TEXT NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	PRIMARY KEY (club_id, user_id)
+);
+CREATE INDEX idx_club_members_user_id ON club_members(user_id);
+
+CREATE TABLE picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL REFERENCES clubs(id),
+	user_id TEXT NOT NULL REFERENCES users(id),
+	title TEXT NOT NULL,
+	year INTEGER NOT NULL DEFAULT 0,
+	notes TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	created_at BIGINT
+);
+CREATE INDEX idx_picks_club_id ON picks(club_id);
+CREATE INDEX idx_picks_user_id ON picks(user_id);
+
+CREATE TABLE scheduled_picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL REFERENCES clubs(id),
+	pick_id TEXT NOT NULL REFERENCES picks(id),
+	sequence_number INTEGER NOT NULL,
+	start_date BIGINT,
+	calendar_uid TEXT NOT NULL DEFAULT '',
+	calendar_sequence INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX idx_scheduled_picks_club_id ON scheduled_picks(club_id);
+CREATE INDEX idx_scheduled_picks_pick_id ON scheduled_picks(pick_id);
+
+CREATE TABLE notification_preferences (
+	user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	unsubscribed_all BOOLEAN NOT NULL DEFAULT FALSE,
+	digest_weekday INTEGER NOT NULL DEFAULT 0,
+	updated_at BIGINT,
+	last_digest_sent_at BIGINT
+);
+
+-- club_id isn't a foreign key, because unsubscribe links can outlive their club
+CREATE TABLE club_notification_preferences (
+	user_id TEXT NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+	club_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	muted BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY (user_id, club_id)
+);
+
+-- club_id is empty for types disabled for all clubs
+CREATE TABLE disabled_notification_types (
+	user_id TEXT NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+	club_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	type INTEGER NOT NULL,
+	PRIMARY KEY (user_id, club_id, position)
+);
+
+CREATE TABLE calendar_feeds (
+	user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	token TEXT NOT NULL UNIQUE,
+	created_at BIGINT
+);
+
+CREATE TABLE app_passwords (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	password_hash TEXT NOT NULL,
+	created_at BIGINT,
+	last_used_at BIGINT
+);
+CREATE INDEX idx_app_passwords_user_id ON app_passwords(user_id);
+`
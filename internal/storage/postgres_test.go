@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+)
+
+// postgresURIEnv names a server to test against instead of launching one, e.g. postgres://postgres@localhost:5432/postgres.
+// Each test creates (and drops) its own database on it.
+const postgresURIEnv = "WATCHCLUB_TEST_POSTGRES_URI"
+
+var testPostgres struct {
+	once sync.Once
+	uri  string
+	cmd  *exec.Cmd
+	dir  string
+	err  error
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if testPostgres.cmd != nil {
+		testPostgres.cmd.Process.Signal(os.Interrupt)
+		testPostgres.cmd.Wait()
+		os.RemoveAll(testPostgres.dir)
+	}
+	os.Exit(code)
+}
+
+// postgresBinary finds a PostgreSQL server program on the PATH or in the usual Debian location
+func postgresBinary(name string) (string, error) {
+	if path, err := exec.LookPath(name); err == nil {
+		return path, nil
+	}
+	matches, _ := filepath.Glob(filepath.Join("/usr/lib/postgresql", "*", "bin", name))
+	if len(matches) == 0 {
+		return "", fmt.Errorf("%s not found", name)
+	}
+	return matches[len(matches)-1], nil
+}
+
+// launchPostgres starts a throwaway PostgreSQL server, returning a URI of its admin database
+func launchPostgres() (string, error) {
+	initdb, err := postgresBinary("initdb")
+	if err != nil {
+		return "", err
+	}
+	postgres, err := postgresBinary("postgres")
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp("", "watchclub-postgres-")
+	if err != nil {
+		return "", err
+	}
+	testPostgres.dir = dir
+	data := filepath.Join(dir, "data")
+	if out, err := exec.Command(initdb, "-D", data, "-U", "postgres", "--auth=trust", "--no-sync").CombinedOutput(); err != nil {
+		return "", fmt.Errorf("initdb failed: %w: %s", err, out)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	cmd := exec.Command(postgres, "-D", data, "-p", fmt.Sprint(port), "-k", dir, "-c", "listen_addresses=127.0.0.1", "-c", "fsync=off")
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	testPostgres.cmd = cmd
+
+	uri := fmt.Sprintf("postgres://postgres@127.0.0.1:%d/postgres?sslmode=disable", port)
+	db, err := sql.Open("pgx", uri)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+	for start := time.Now(); ; time.Sleep(100 * time.Millisecond) {
+		if err = db.Ping(); err == nil {
+			return uri, nil
+		}
+		if time.Since(start) > 30*time.Second {
+			return "", fmt.Errorf("postgres didn't start: %w", err)
+		}
+	}
+}
+
+// newTestPostgresURI creates an empty database for a test, skipping the test if there's no PostgreSQL to use
+func newTestPostgresURI(t *testing.T) string {
+	testPostgres.once.Do(func() {
+		testPostgres.uri = os.Getenv(postgresURIEnv)
+		if testPostgres.uri == "" {
+			testPostgres.uri, testPostgres.err = launchPostgres()
+		}
+	})
+	if testPostgres.err != nil {
+		t.Skipf("PostgreSQL isn't available (set %s to use a running server): %v", postgresURIEnv, testPostgres.err)
+	}
+
+	admin, err := sql.Open("pgx", testPostgres.uri)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close() })
+
+	name := "watchclub_test_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	_, err = admin.Exec("CREATE DATABASE " + name)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Exec("DROP DATABASE " + name + " WITH (FORCE)") })
+
+	u, err := url.Parse(testPostgres.uri)
+	require.NoError(t, err)
+	u.Path = "/" + name
+	return u.String()
+}
+
+func newTestPostgresStorage(t *testing.T, uri string) *sqlStorage {
+	store, err := NewPostgresStorage(uri)
+	require.NoError(t, err)
+	s := store.(*sqlStorage)
+	t.Cleanup(func() { s.db.Close() })
+	return s
+}
+
+func Test_Postgres_Conformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		return newTestPostgresStorage(t, newTestPostgresURI(t))
+	})
+}
+
+func Test_Postgres_Constraints(t *testing.T) {
+	testSQLConstraints(t, newTestPostgresStorage(t, newTestPostgresURI(t)))
+}
+
+func Test_Postgres_Migrate(t *testing.T) {
+	ctx := context.Background()
+	uri := newTestPostgresURI(t)
+	pooled, err := url.Parse(uri)
+	require.NoError(t, err)
+	query := pooled.Query()
+	query.Set("max_open_conns", "2")
+	query.Set("conn_max_lifetime", "1m")
+	pooled.RawQuery = query.Encode()
+	s := newTestPostgresStorage(t, pooled.String())
+	assert.Equal(t, 2, s.db.Stats().MaxOpenConnections)
+
+	prefs := &v1.NotificationPreferences{
+		UserId:          "jo",
+		UnsubscribedAll: true,
+		DisabledTypes:   []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_REMINDERS},
+		Clubs:           []*v1.ClubNotificationPreferences{{ClubId: "club", Muted: true}},
+		DigestWeekday:   v1.Weekday_WEEKDAY_FRIDAY,
+	}
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, s.PutNotificationPreferences(ctx, prefs))
+
+	// opening it again keeps the data and doesn't migrate again
+	s = newTestPostgresStorage(t, uri)
+	got, err := s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, prefs, got)
+
+	_, err = s.db.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (1000, 'future', 0)")
+	require.NoError(t, err)
+	_, err = NewPostgresStorage(uri)
+	assert.ErrorContains(t, err, "newer")
+}
+
+func Test_Postgres_InvalidURI(t *testing.T) {
+	_, err := NewStorage("postgres://localhost/watchclub?max_open_conns=many")
+	assert.ErrorContains(t, err, "max_open_conns")
+}
+
+func Test_Postgres_Rebind(t *testing.T) {
+	s := &sqlStorage{postgres: true}
+	assert.Equal(t, "SELECT a FROM b WHERE c = $1 AND d IN (SELECT e FROM f WHERE g = $2)",
+		s.rebind("SELECT a FROM b WHERE c = ? AND d IN (SELECT e FROM f WHERE g = ?)"))
+	assert.Equal(t, "SELECT a FROM b WHERE c = ?", (&sqlStorage{}).rebind("SELECT a FROM b WHERE c = ?"))
+}
@@ -0,0 +1,1218 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/keyring"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sqlStorage implements Storage on a SQL database. SQLite and PostgreSQL share it,
+// with queries written using ? placeholders that are rebound for the database.
+type sqlStorage struct {
+	db *sql.DB
+	// reader is a separate pool for queries outside of transactions, if the database has one
+	reader   *sql.DB
+	postgres bool
+	// keys encrypt email addresses, which are stored in plain text if it's nil
+	keys *keyring.Keyring
+}
+
+// Close closes the database
+func (s *sqlStorage) Close() error {
+	if s.reader != nil {
+		return errors.Join(s.reader.Close(), s.db.Close())
+	}
+	return s.db.Close()
+}
+
+// readDB returns the pool for queries outside of transactions
+func (s *sqlStorage) readDB() *sql.DB {
+	if s.reader != nil {
+		return s.reader
+	}
+	return s.db
+}
+
+// sqlScanner is implemented by *sql.Row and *sql.Rows
+type sqlScanner interface {
+	Scan(dest ...any) error
+}
+
+// sqlExecer is implemented by *sql.DB and *sql.Tx
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// sqlQueryer is implemented by *sql.DB and *sql.Tx
+type sqlQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// sqlMigration is a numbered change to a database schema.
+// Migrations are applied in order, each in its own transaction, and recorded in schema_migrations.
+// Once released, a migration must never change; add a new one instead.
+type sqlMigration struct {
+	version int
+	name    string
+	up      func(ctx context.Context, tx *sql.Tx) error
+}
+
+func execMigration(statements string) func(ctx context.Context, tx *sql.Tx) error {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, statements)
+		return err
+	}
+}
+
+// rebind rewrites ? placeholders to PostgreSQL's $1, $2, ...
+func (s *sqlStorage) rebind(query string) string {
+	if !s.postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (s *sqlStorage) exec(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	result, err := s.db.ExecContext(ctx, s.rebind(query), args...)
+	return result, s.constraintError(err)
+}
+
+func (s *sqlStorage) query(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return s.readDB().QueryContext(ctx, s.rebind(query), args...)
+}
+
+func (s *sqlStorage) queryRow(ctx context.Context, query string, args ...any) *sql.Row {
+	return s.readDB().QueryRowContext(ctx, s.rebind(query), args...)
+}
+
+// txExec runs a statement in a transaction, or on the database outside of one
+func (s *sqlStorage) txExec(ctx context.Context, tx sqlExecer, query string, args ...any) (sql.Result, error) {
+	result, err := tx.ExecContext(ctx, s.rebind(query), args...)
+	return result, s.constraintError(err)
+}
+
+// constraintError wraps the error from a violated constraint in the matching Storage error:
+// unique constraints are ErrAlreadyExists and foreign keys are ErrConflict
+func (s *sqlStorage) constraintError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var sentinel error
+	if s.postgres {
+		sentinel = postgresConstraintError(err)
+	} else {
+		sentinel = sqliteConstraintError(err)
+	}
+	if sentinel == nil {
+		return err
+	}
+	return fmt.Errorf("%w: %w", sentinel, err)
+}
+
+// inTx runs fn in a transaction, which is committed if fn succeeds
+func (s *sqlStorage) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// sqlTime converts a timestamp to Unix nanoseconds, or NULL if it's unset
+func sqlTime(ts *timestamppb.Timestamp) any {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime().UnixNano()
+}
+
+func timestampFromSQL(v sql.NullInt64) *timestamppb.Timestamp {
+	if !v.Valid {
+		return nil
+	}
+	return timestamppb.New(time.Unix(0, v.Int64))
+}
+
+// deleteByID deletes a row, returning a not found error if it doesn't exist
+func (s *sqlStorage) deleteByID(ctx context.Context, tx sqlExecer, table string, entity string, id string) error {
+	result, err := s.txExec(ctx, tx, "DELETE FROM "+table+" WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", entity, err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return notFound(entity, id)
+	}
+
+	return nil
+}
+
+// sqlConditions builds a WHERE clause out of conditions that must all hold
+type sqlConditions struct {
+	conditions []string
+	args       []any
+}
+
+func (c *sqlConditions) add(condition string, args ...any) {
+	c.conditions = append(c.conditions, "("+condition+")")
+	c.args = append(c.args, args...)
+}
+
+// addCreatedAfter adds the condition that rows come after a page key in creation time (unset first) then ID order
+func (c *sqlConditions) addCreatedAfter(after *pageKey) {
+	switch {
+	case after == nil:
+	case after.CreatedAt == nil:
+		c.add("created_at IS NOT NULL OR id > ?", after.ID)
+	default:
+		c.add("created_at > ? OR (created_at = ? AND id > ?)", *after.CreatedAt, *after.CreatedAt, after.ID)
+	}
+}
+
+func (c *sqlConditions) where() string {
+	if len(c.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(c.conditions, " AND ")
+}
+
+// sqlLimit returns a LIMIT clause, or nothing if the limit is zero
+func sqlLimit(limit int) string {
+	if limit == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" LIMIT %d", limit)
+}
+
+// sqlIn returns a condition that a column is one of the given values, along with its arguments
+func sqlIn(column string, values []string) (string, []any) {
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return column + " IN (?" + strings.Repeat(", ?", len(values)-1) + ")", args
+}
+
+// User operations
+
+const userColumns = "id, name, email, created_at"
+
+func (s *sqlStorage) scanUser(row sqlScanner) (*v1.User, error) {
+	user := &v1.User{}
+	var createdAt sql.NullInt64
+	if err := row.Scan(&user.Id, &user.Name, &user.Email, &createdAt); err != nil {
+		return nil, err
+	}
+	user.CreatedAt = timestampFromSQL(createdAt)
+	email, err := s.openEmail(user.Id, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	user.Email = email
+	return user, nil
+}
+
+func (s *sqlStorage) CreateUser(ctx context.Context, user *v1.User) error {
+	email, emailIndex, err := s.sealEmail(user.Id, user.Email)
+	if err != nil {
+		return err
+	}
+	_, err = s.exec(ctx, "INSERT INTO users ("+userColumns+", email_index) VALUES (?, ?, ?, ?, ?)",
+		user.Id, user.Name, email, sqlTime(user.CreatedAt), emailIndex)
+	if err != nil {
+		return fmt.Errorf("failed to insert user: %w", err)
+	}
+
+	return nil
+}
+
+func (s *sqlStorage) GetUser(ctx context.Context, id string) (*v1.User, error) {
+	user, err := s.scanUser(s.queryRow(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, notFound("user", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *sqlStorage) GetUserByEmail(ctx context.Context, email string) (*v1.User, error) {
+	// Encrypted addresses are looked up by their blind index, and any that haven't been encrypted yet by value
+	query, args := "SELECT "+userColumns+" FROM users WHERE email = ?", []any{email}
+	if s.keys != nil {
+		query = "SELECT " + userColumns + " FROM users WHERE email_index = ? OR (email_index IS NULL AND email = ?)"
+		args = []any{s.keys.Index(email, emailIndexContext), email}
+	}
+	user, err := s.scanUser(s.queryRow(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, newError(ErrNotFound, "user %s with email: %s", ErrNotFound, email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *sqlStorage) ListUsers(ctx context.Context) ([]*v1.User, error) {
+	return s.queryUsers(ctx, "")
+}
+
+func (s *sqlStorage) GetUsers(ctx context.Context, ids []string) ([]*v1.User, error) {
+	if len(ids) == 0 {
+		return []*v1.User{}, nil
+	}
+	where, args := sqlIn("id", ids)
+	users, err := s.queryUsers(ctx, "WHERE "+where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[string]*v1.User, len(users))
+	for _, user := range users {
+		usersByID[user.Id] = user
+	}
+	users = users[:0]
+	for _, id := range ids {
+		if user, ok := usersByID[id]; ok {
+			users = append(users, user)
+			delete(usersByID, id)
+		}
+	}
+	return users, nil
+}
+
+// queryUsers gets the users matching a WHERE clause
+func (s *sqlStorage) queryUsers(ctx context.Context, where string, args ...any) ([]*v1.User, error) {
+	rows, err := s.query(ctx, "SELECT "+userColumns+" FROM users "+where+" ORDER BY created_at NULLS FIRST, id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*v1.User
+	for rows.Next() {
+		user, err := s.scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// DeleteUser deletes a user and their picks. Their memberships, notification preferences,
+// calendar feed, and app passwords are deleted by the foreign keys.
+func (s *sqlStorage) DeleteUser(ctx context.Context, id string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := s.deletePicks(ctx, tx, "user_id = ?", id); err != nil {
+			return err
+		}
+		return s.deleteByID(ctx, tx, "users", "user", id)
+	})
+}
+
+// Club operations
+
+const clubColumns = "id, name, start_date, started, created_at, updated_at, max_picks_per_member, schedule_interval_quantity, schedule_interval_unit"
+
+func (s *sqlStorage) CreateClub(ctx context.Context, club *v1.Club) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		_, err := s.txExec(ctx, tx, "INSERT INTO clubs ("+clubColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			club.Id, club.Name, sqlTime(club.StartDate), club.Started, sqlTime(club.CreatedAt), sqlTime(club.UpdatedAt),
+			club.MaxPicksPerMember, club.ScheduleIntervalQuantity, int32(club.ScheduleIntervalUnit))
+		if err != nil {
+			return fmt.Errorf("failed to insert club: %w", err)
+		}
+		return s.insertClubMembers(ctx, tx, club)
+	})
+}
+
+func (s *sqlStorage) UpdateClub(ctx context.Context, club *v1.Club) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		return s.updateClub(ctx, tx, club)
+	})
+}
+
+func (s *sqlStorage) updateClub(ctx context.Context, tx *sql.Tx, club *v1.Club) error {
+	result, err := s.txExec(ctx, tx, `UPDATE clubs SET name = ?, start_date = ?, started = ?, created_at = ?, updated_at = ?,
+		max_picks_per_member = ?, schedule_interval_quantity = ?, schedule_interval_unit = ? WHERE id = ? AND deleted_at IS NULL`,
+		club.Name, sqlTime(club.StartDate), club.Started, sqlTime(club.CreatedAt), sqlTime(club.UpdatedAt),
+		club.MaxPicksPerMember, club.ScheduleIntervalQuantity, int32(club.ScheduleIntervalUnit), club.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update club: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound("club", club.Id)
+	}
+
+	if _, err := s.txExec(ctx, tx, "DELETE FROM club_members WHERE club_id = ?", club.Id); err != nil {
+		return fmt.Errorf("failed to delete club members: %w", err)
+	}
+	return s.insertClubMembers(ctx, tx, club)
+}
+
+func (s *sqlStorage) insertClubMembers(ctx context.Context, tx *sql.Tx, club *v1.Club) error {
+	for i, memberID := range club.MemberIds {
+		_, err := s.txExec(ctx, tx, "INSERT INTO club_members (club_id, user_id, position) VALUES (?, ?, ?)", club.Id, memberID, i)
+		if err != nil {
+			return fmt.Errorf("failed to insert club member %s: %w", memberID, err)
+		}
+	}
+	return nil
+}
+
+func (s *sqlStorage) GetClub(ctx context.Context, id string) (*v1.Club, error) {
+	var c sqlConditions
+	c.add("id = ?", id)
+	c.add("deleted_at IS NULL")
+	clubs, err := s.queryClubs(ctx, c, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(clubs) == 0 {
+		return nil, notFound("club", id)
+	}
+
+	return clubs[0], nil
+}
+
+func (s *sqlStorage) ListClubs(ctx context.Context) ([]*v1.Club, error) {
+	var c sqlConditions
+	c.add("deleted_at IS NULL")
+	return s.queryClubs(ctx, c, 0)
+}
+
+func (s *sqlStorage) ListClubsForUser(ctx context.Context, userID string) ([]*v1.Club, error) {
+	var c sqlConditions
+	c.add("id IN (SELECT club_id FROM club_members WHERE user_id = ?)", userID)
+	c.add("deleted_at IS NULL")
+	return s.queryClubs(ctx, c, 0)
+}
+
+func (s *sqlStorage) ListDeletedClubs(ctx context.Context, userID string) ([]*v1.Club, error) {
+	var c sqlConditions
+	c.add("id IN (SELECT club_id FROM club_members WHERE user_id = ?)", userID)
+	c.add("deleted_at IS NOT NULL")
+	return s.queryClubs(ctx, c, 0)
+}
+
+func (s *sqlStorage) QueryClubs(ctx context.Context, query ClubQuery) ([]*v1.Club, string, error) {
+	after, err := query.Page.after(query.fingerprint())
+	if err != nil {
+		return nil, "", err
+	}
+
+	var c sqlConditions
+	c.add("deleted_at IS NULL")
+	if query.MemberID != "" {
+		c.add("id IN (SELECT club_id FROM club_members WHERE user_id = ?)", query.MemberID)
+	}
+	if query.Started != nil {
+		c.add("started = ?", *query.Started)
+	}
+	c.addCreatedAfter(after)
+	clubs, err := s.queryClubs(ctx, c, query.Page.limit())
+	if err != nil {
+		return nil, "", err
+	}
+	clubs, next := nextPage(clubs, query.Page, query.fingerprint(), clubKey)
+	return clubs, next, nil
+}
+
+// queryClubs gets the clubs matching some conditions, up to a limit if it isn't zero, along with their members
+func (s *sqlStorage) queryClubs(ctx context.Context, c sqlConditions, limit int) ([]*v1.Club, error) {
+	rows, err := s.query(ctx, "SELECT "+clubColumns+", deleted_at FROM clubs "+c.where()+" ORDER BY created_at NULLS FIRST, id"+sqlLimit(limit), c.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query clubs: %w", err)
+	}
+	defer rows.Close()
+
+	var clubs []*v1.Club
+	clubsByID := make(map[string]*v1.Club)
+	for rows.Next() {
+		club := &v1.Club{}
+		var startDate, createdAt, updatedAt, deletedAt sql.NullInt64
+		var unit int32
+		err := rows.Scan(&club.Id, &club.Name, &startDate, &club.Started, &createdAt, &updatedAt,
+			&club.MaxPicksPerMember, &club.ScheduleIntervalQuantity, &unit, &deletedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan club: %w", err)
+		}
+		club.StartDate = timestampFromSQL(startDate)
+		club.CreatedAt = timestampFromSQL(createdAt)
+		club.UpdatedAt = timestampFromSQL(updatedAt)
+		club.DeletedAt = timestampFromSQL(deletedAt)
+		club.ScheduleIntervalUnit = v1.ScheduleIntervalUnit(unit)
+
+		clubs = append(clubs, club)
+		clubsByID[club.Id] = club
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query clubs: %w", err)
+	}
+	rows.Close()
+
+	if len(clubs) == 0 {
+		return clubs, nil
+	}
+
+	clubIDs := make([]string, 0, len(clubs))
+	for _, club := range clubs {
+		clubIDs = append(clubIDs, club.Id)
+	}
+	in, args := sqlIn("club_id", clubIDs)
+	members, err := s.query(ctx, "SELECT club_id, user_id FROM club_members WHERE "+in+" ORDER BY club_id, position", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query club members: %w", err)
+	}
+	defer members.Close()
+
+	for members.Next() {
+		var clubID, userID string
+		if err := members.Scan(&clubID, &userID); err != nil {
+			return nil, fmt.Errorf("failed to scan club member: %w", err)
+		}
+		if club, ok := clubsByID[clubID]; ok {
+			club.MemberIds = append(club.MemberIds, userID)
+		}
+	}
+
+	return clubs, members.Err()
+}
+
+// DeleteClub marks a club deleted, which hides it along with its picks and schedule until it's restored or purged
+func (s *sqlStorage) DeleteClub(ctx context.Context, id string) error {
+	return s.softDelete(ctx, "clubs", "club", id, "deleted_at IS NULL")
+}
+
+func (s *sqlStorage) RestoreClub(ctx context.Context, id string) error {
+	result, err := s.exec(ctx, "UPDATE clubs SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		return fmt.Errorf("failed to restore club: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound("deleted club", id)
+	}
+	return nil
+}
+
+// Pick operations
+
+const pickColumns = "id, club_id, user_id, title, year, notes, link, created_at"
+
+// scanPick scans a row of pickColumns followed by deleted_at
+func scanPick(row sqlScanner) (*v1.Pick, error) {
+	pick := &v1.Pick{}
+	var createdAt, deletedAt sql.NullInt64
+	if err := row.Scan(&pick.Id, &pick.ClubId, &pick.UserId, &pick.Title, &pick.Year, &pick.Notes, &pick.Link, &createdAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	pick.CreatedAt = timestampFromSQL(createdAt)
+	pick.DeletedAt = timestampFromSQL(deletedAt)
+	return pick, nil
+}
+
+func (s *sqlStorage) CreatePick(ctx context.Context, pick *v1.Pick) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := s.checkVisible(ctx, tx, "clubs", "club", pick.ClubId, "deleted_at IS NULL"); err != nil {
+			return err
+		}
+		_, err := s.txExec(ctx, tx, "INSERT INTO picks ("+pickColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			pick.Id, pick.ClubId, pick.UserId, pick.Title, pick.Year, pick.Notes, pick.Link, sqlTime(pick.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to insert pick: %w", err)
+		}
+		return nil
+	})
+}
+
+// checkVisible returns a conflict error unless a record that a write refers to exists, and matches a condition
+// that leaves out deleted records. Foreign keys only catch records that don't exist at all.
+func (s *sqlStorage) checkVisible(ctx context.Context, tx *sql.Tx, table string, entity string, id string, condition string) error {
+	var visible bool
+	err := tx.QueryRowContext(ctx, s.rebind("SELECT EXISTS (SELECT 1 FROM "+table+" WHERE id = ? AND "+condition+")"), id).Scan(&visible)
+	if err != nil {
+		return fmt.Errorf("failed to check %s: %w", entity, err)
+	}
+	if !visible {
+		return conflict("%s doesn't exist: %s", entity, id)
+	}
+	return nil
+}
+
+// sqlVisiblePick is the condition on picks that hides deleted picks and the picks of deleted clubs
+const sqlVisiblePick = "deleted_at IS NULL AND club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)"
+
+func (s *sqlStorage) GetPick(ctx context.Context, id string) (*v1.Pick, error) {
+	pick, err := scanPick(s.queryRow(ctx, "SELECT "+pickColumns+", deleted_at FROM picks WHERE id = ? AND "+sqlVisiblePick, id))
+	if err == sql.ErrNoRows {
+		return nil, notFound("pick", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pick: %w", err)
+	}
+
+	return pick, nil
+}
+
+func (s *sqlStorage) ListPicks(ctx context.Context, clubID string) ([]*v1.Pick, error) {
+	return s.ListPicksForClubs(ctx, []string{clubID})
+}
+
+func (s *sqlStorage) ListPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.Pick, error) {
+	if len(clubIDs) == 0 {
+		return []*v1.Pick{}, nil
+	}
+	var c sqlConditions
+	in, args := sqlIn("club_id", clubIDs)
+	c.add(in, args...)
+	c.add(sqlVisiblePick)
+	return s.queryPicks(ctx, c, 0)
+}
+
+func (s *sqlStorage) QueryPicks(ctx context.Context, query PickQuery) ([]*v1.Pick, string, error) {
+	after, err := query.Page.after(query.fingerprint())
+	if err != nil {
+		return nil, "", err
+	}
+
+	var c sqlConditions
+	c.add("club_id = ?", query.ClubID)
+	if query.UserID != "" {
+		c.add("user_id = ?", query.UserID)
+	}
+	c.add(sqlVisiblePick)
+	c.addCreatedAfter(after)
+	picks, err := s.queryPicks(ctx, c, query.Page.limit())
+	if err != nil {
+		return nil, "", err
+	}
+	picks, next := nextPage(picks, query.Page, query.fingerprint(), pickKey)
+	return picks, next, nil
+}
+
+// queryPicks gets the picks matching some conditions, up to a limit if it isn't zero.
+// Deleted picks are included unless the conditions leave them out with sqlVisiblePick.
+func (s *sqlStorage) queryPicks(ctx context.Context, c sqlConditions, limit int) ([]*v1.Pick, error) {
+	picks := []*v1.Pick{}
+	rows, err := s.query(ctx, "SELECT "+pickColumns+", deleted_at FROM picks "+c.where()+" ORDER BY created_at NULLS FIRST, id"+sqlLimit(limit), c.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query picks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		pick, err := scanPick(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pick: %w", err)
+		}
+		picks = append(picks, pick)
+	}
+
+	return picks, rows.Err()
+}
+
+// deletePicks deletes the picks matching a WHERE condition, along with their scheduled picks, and returns how many were deleted.
+// Picks and scheduled picks don't cascade in the schema, so that picks can't be deleted from under a schedule by accident.
+func (s *sqlStorage) deletePicks(ctx context.Context, tx *sql.Tx, where string, args ...any) (int64, error) {
+	if _, err := s.txExec(ctx, tx, "DELETE FROM scheduled_picks WHERE pick_id IN (SELECT id FROM picks WHERE "+where+")", args...); err != nil {
+		return 0, fmt.Errorf("failed to delete scheduled picks: %w", err)
+	}
+	result, err := s.txExec(ctx, tx, "DELETE FROM picks WHERE "+where, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete picks: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return deleted, nil
+}
+
+// DeletePick marks a pick deleted, unless it's scheduled
+func (s *sqlStorage) DeletePick(ctx context.Context, id string) error {
+	err := s.softDelete(ctx, "picks", "pick", id, sqlVisiblePick+" AND id NOT IN (SELECT pick_id FROM scheduled_picks s WHERE "+sqlVisibleScheduledPick+")")
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if _, getErr := s.GetPick(ctx, id); getErr == nil {
+		return conflict("pick is scheduled: %s", id)
+	}
+	return err
+}
+
+func (s *sqlStorage) ListDeletedPicks(ctx context.Context, clubID string, userID string) ([]*v1.Pick, error) {
+	var c sqlConditions
+	c.add("club_id = ? AND user_id = ?", clubID, userID)
+	c.add("deleted_at IS NOT NULL AND club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)")
+	return s.queryPicks(ctx, c, 0)
+}
+
+// RestorePick restores a deleted pick, unless its club is deleted too, in which case it's restored with its club
+func (s *sqlStorage) RestorePick(ctx context.Context, id string) error {
+	result, err := s.exec(ctx, `UPDATE picks SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL
+		AND club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)`, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore pick: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound("deleted pick", id)
+	}
+	return nil
+}
+
+// softDelete marks a row matching a condition deleted, returning a not found error if there isn't one
+func (s *sqlStorage) softDelete(ctx context.Context, table string, entity string, id string, condition string) error {
+	result, err := s.exec(ctx, "UPDATE "+table+" SET deleted_at = ? WHERE id = ? AND "+condition, time.Now().UnixNano(), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", entity, err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound(entity, id)
+	}
+	return nil
+}
+
+// PurgeDeleted deletes the clubs and picks that were marked deleted before a time. Clubs are deleted along with
+// their picks and schedule, and their memberships are deleted by the foreign keys.
+func (s *sqlStorage) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	cutoff := deletedBefore.UnixNano()
+	var purged int64
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		const purgedClubs = "club_id IN (SELECT id FROM clubs WHERE deleted_at < ?)"
+		if _, err := s.txExec(ctx, tx, "DELETE FROM scheduled_picks WHERE "+purgedClubs, cutoff); err != nil {
+			return fmt.Errorf("failed to delete scheduled picks: %w", err)
+		}
+		if _, err := s.deletePicks(ctx, tx, purgedClubs, cutoff); err != nil {
+			return err
+		}
+		result, err := s.txExec(ctx, tx, "DELETE FROM clubs WHERE deleted_at < ?", cutoff)
+		if err != nil {
+			return fmt.Errorf("failed to delete clubs: %w", err)
+		}
+		if purged, err = result.RowsAffected(); err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		picks, err := s.deletePicks(ctx, tx, "deleted_at < ?", cutoff)
+		if err != nil {
+			return err
+		}
+		purged += picks
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
+// ScheduledPick operations
+
+// scheduledPickQuery selects scheduled picks along with their picks
+const scheduledPickQuery = `SELECT s.id, s.club_id, s.sequence_number, s.start_date, s.calendar_uid, s.calendar_sequence,
+	p.id, p.club_id, p.user_id, p.title, p.year, p.notes, p.link, p.created_at
+	FROM scheduled_picks s JOIN picks p ON p.id = s.pick_id`
+
+// sqlVisibleScheduledPick is the condition on scheduled picks that hides the schedules of deleted clubs
+const sqlVisibleScheduledPick = "s.club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)"
+
+func scanScheduledPick(row sqlScanner) (*v1.ScheduledPick, error) {
+	assignment := &v1.ScheduledPick{Pick: &v1.Pick{}}
+	pick := assignment.Pick
+	var startDate, pickCreatedAt sql.NullInt64
+	err := row.Scan(&assignment.Id, &assignment.ClubId, &assignment.SequenceNumber, &startDate, &assignment.CalendarUid, &assignment.CalendarSequence,
+		&pick.Id, &pick.ClubId, &pick.UserId, &pick.Title, &pick.Year, &pick.Notes, &pick.Link, &pickCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	assignment.StartDate = timestampFromSQL(startDate)
+	pick.CreatedAt = timestampFromSQL(pickCreatedAt)
+	return assignment, nil
+}
+
+func (s *sqlStorage) CreateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error {
+	if assignment.Pick == nil {
+		return fmt.Errorf("scheduled pick has no pick: %s", assignment.Id)
+	}
+
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := s.checkScheduledPick(ctx, tx, assignment); err != nil {
+			return err
+		}
+		_, err := s.txExec(ctx, tx, `INSERT INTO scheduled_picks (id, club_id, pick_id, sequence_number, start_date, calendar_uid, calendar_sequence)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			assignment.Id, assignment.ClubId, assignment.Pick.Id, assignment.SequenceNumber, sqlTime(assignment.StartDate),
+			assignment.CalendarUid, assignment.CalendarSequence)
+		if err != nil {
+			return fmt.Errorf("failed to insert scheduled pick: %w", err)
+		}
+		return nil
+	})
+}
+
+// checkScheduledPick checks that a scheduled pick's club and pick haven't been deleted
+func (s *sqlStorage) checkScheduledPick(ctx context.Context, tx *sql.Tx, assignment *v1.ScheduledPick) error {
+	if err := s.checkVisible(ctx, tx, "clubs", "club", assignment.ClubId, "deleted_at IS NULL"); err != nil {
+		return err
+	}
+	return s.checkVisible(ctx, tx, "picks", "pick", assignment.Pick.Id, sqlVisiblePick)
+}
+
+func (s *sqlStorage) GetScheduledPick(ctx context.Context, id string) (*v1.ScheduledPick, error) {
+	assignment, err := scanScheduledPick(s.queryRow(ctx, scheduledPickQuery+" WHERE s.id = ? AND "+sqlVisibleScheduledPick, id))
+	if err == sql.ErrNoRows {
+		return nil, notFound("scheduled pick", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scheduled pick: %w", err)
+	}
+
+	return assignment, nil
+}
+
+func (s *sqlStorage) ListScheduledPicks(ctx context.Context, clubID string) ([]*v1.ScheduledPick, error) {
+	return s.ListScheduledPicksForClubs(ctx, []string{clubID})
+}
+
+func (s *sqlStorage) ListScheduledPicksForClubs(ctx context.Context, clubIDs []string) ([]*v1.ScheduledPick, error) {
+	if len(clubIDs) == 0 {
+		return []*v1.ScheduledPick{}, nil
+	}
+	var c sqlConditions
+	in, args := sqlIn("s.club_id", clubIDs)
+	c.add(in, args...)
+	return s.queryScheduledPicks(ctx, c, 0)
+}
+
+func (s *sqlStorage) QueryScheduledPicks(ctx context.Context, query ScheduledPickQuery) ([]*v1.ScheduledPick, string, error) {
+	query, after, err := query.pinned()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var c sqlConditions
+	c.add("s.club_id = ?", query.ClubID)
+	if !query.StartingFrom.IsZero() {
+		c.add("s.start_date >= ?", query.StartingFrom.UnixNano())
+	}
+	if after != nil {
+		c.add("s.sequence_number > ? OR (s.sequence_number = ? AND s.id > ?)", after.Sequence, after.Sequence, after.ID)
+	}
+	assignments, err := s.queryScheduledPicks(ctx, c, query.Page.limit())
+	if err != nil {
+		return nil, "", err
+	}
+	assignments, next := nextPage(assignments, query.Page, query.fingerprint(), query.key)
+	return assignments, next, nil
+}
+
+// queryScheduledPicks gets the scheduled picks matching some conditions, up to a limit if it isn't zero
+func (s *sqlStorage) queryScheduledPicks(ctx context.Context, c sqlConditions, limit int) ([]*v1.ScheduledPick, error) {
+	return s.queryScheduledPicksIn(ctx, s.readDB(), c, limit)
+}
+
+// queryScheduledPicksIn queries scheduled picks with db, which is a transaction when they're read as part of one
+func (s *sqlStorage) queryScheduledPicksIn(ctx context.Context, db sqlQueryer, c sqlConditions, limit int) ([]*v1.ScheduledPick, error) {
+	assignments := []*v1.ScheduledPick{}
+	c.add(sqlVisibleScheduledPick)
+	rows, err := db.QueryContext(ctx, s.rebind(scheduledPickQuery+" "+c.where()+" ORDER BY s.club_id, s.sequence_number, s.id"+sqlLimit(limit)), c.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scheduled picks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		assignment, err := scanScheduledPick(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled pick: %w", err)
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (s *sqlStorage) UpdateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error {
+	if assignment.Pick == nil {
+		return fmt.Errorf("scheduled pick has no pick: %s", assignment.Id)
+	}
+
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		result, err := s.txExec(ctx, tx, `UPDATE scheduled_picks SET club_id = ?, pick_id = ?, sequence_number = ?, start_date = ?,
+			calendar_uid = ?, calendar_sequence = ? WHERE id = ? AND club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)`,
+			assignment.ClubId, assignment.Pick.Id, assignment.SequenceNumber, sqlTime(assignment.StartDate),
+			assignment.CalendarUid, assignment.CalendarSequence, assignment.Id)
+		if err != nil {
+			return fmt.Errorf("failed to update scheduled pick: %w", err)
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rows == 0 {
+			return notFound("scheduled pick", assignment.Id)
+		}
+
+		// the update is rolled back if it moved the scheduled pick to a deleted club or pick
+		return s.checkScheduledPick(ctx, tx, assignment)
+	})
+}
+
+func (s *sqlStorage) DeleteScheduledPick(ctx context.Context, id string) error {
+	result, err := s.exec(ctx, "DELETE FROM scheduled_picks WHERE id = ? AND club_id IN (SELECT id FROM clubs WHERE deleted_at IS NULL)", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scheduled pick: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return notFound("scheduled pick", id)
+	}
+	return nil
+}
+
+func (s *sqlStorage) ResetSchedule(ctx context.Context, club *v1.Club) ([]*v1.ScheduledPick, error) {
+	var assignments []*v1.ScheduledPick
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := s.updateClub(ctx, tx, club); err != nil {
+			return err
+		}
+		var c sqlConditions
+		c.add("s.club_id = ?", club.Id)
+		var err error
+		assignments, err = s.queryScheduledPicksIn(ctx, tx, c, 0)
+		if err != nil {
+			return err
+		}
+		if _, err := s.txExec(ctx, tx, "DELETE FROM scheduled_picks WHERE club_id = ?", club.Id); err != nil {
+			return fmt.Errorf("failed to delete scheduled picks: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// NotificationPreferences operations
+
+func (s *sqlStorage) GetNotificationPreferences(ctx context.Context, userID string) (*v1.NotificationPreferences, error) {
+	prefs := &v1.NotificationPreferences{UserId: userID}
+	var weekday int32
+	var updatedAt, lastDigestSentAt sql.NullInt64
+	err := s.queryRow(ctx, `SELECT unsubscribed_all, digest_weekday, updated_at, last_digest_sent_at
+		FROM notification_preferences WHERE user_id = ?`, userID).Scan(&prefs.UnsubscribedAll, &weekday, &updatedAt, &lastDigestSentAt)
+	if err == sql.ErrNoRows {
+		return nil, notFound("notification preferences", userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification preferences: %w", err)
+	}
+	prefs.DigestWeekday = v1.Weekday(weekday)
+	prefs.UpdatedAt = timestampFromSQL(updatedAt)
+	prefs.LastDigestSentAt = timestampFromSQL(lastDigestSentAt)
+
+	clubs := make(map[string]*v1.ClubNotificationPreferences)
+	clubRows, err := s.query(ctx, "SELECT club_id, muted FROM club_notification_preferences WHERE user_id = ? ORDER BY position", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query club notification preferences: %w", err)
+	}
+	defer clubRows.Close()
+	for clubRows.Next() {
+		club := &v1.ClubNotificationPreferences{}
+		if err := clubRows.Scan(&club.ClubId, &club.Muted); err != nil {
+			return nil, fmt.Errorf("failed to scan club notification preferences: %w", err)
+		}
+		prefs.Clubs = append(prefs.Clubs, club)
+		clubs[club.ClubId] = club
+	}
+	if err := clubRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query club notification preferences: %w", err)
+	}
+	clubRows.Close()
+
+	typeRows, err := s.query(ctx, "SELECT club_id, type FROM disabled_notification_types WHERE user_id = ? ORDER BY club_id, position", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query disabled notification types: %w", err)
+	}
+	defer typeRows.Close()
+	for typeRows.Next() {
+		var clubID string
+		var t int32
+		if err := typeRows.Scan(&clubID, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan disabled notification type: %w", err)
+		}
+		if clubID == "" {
+			prefs.DisabledTypes = append(prefs.DisabledTypes, v1.NotificationType(t))
+		} else if club, ok := clubs[clubID]; ok {
+			club.DisabledTypes = append(club.DisabledTypes, v1.NotificationType(t))
+		}
+	}
+
+	return prefs, typeRows.Err()
+}
+
+func (s *sqlStorage) PutNotificationPreferences(ctx context.Context, prefs *v1.NotificationPreferences) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		return s.putNotificationPreferences(ctx, tx, prefs)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return nil
+}
+
+// putNotificationPreferences replaces a user's notification preferences
+func (s *sqlStorage) putNotificationPreferences(ctx context.Context, tx *sql.Tx, prefs *v1.NotificationPreferences) error {
+	_, err := s.txExec(ctx, tx, `INSERT INTO notification_preferences (user_id, unsubscribed_all, digest_weekday, updated_at, last_digest_sent_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET unsubscribed_all = excluded.unsubscribed_all, digest_weekday = excluded.digest_weekday,
+			updated_at = excluded.updated_at, last_digest_sent_at = excluded.last_digest_sent_at`,
+		prefs.UserId, prefs.UnsubscribedAll, int32(prefs.DigestWeekday), sqlTime(prefs.UpdatedAt), sqlTime(prefs.LastDigestSentAt))
+	if err != nil {
+		return err
+	}
+
+	for _, table := range []string{"club_notification_preferences", "disabled_notification_types"} {
+		if _, err := s.txExec(ctx, tx, "DELETE FROM "+table+" WHERE user_id = ?", prefs.UserId); err != nil {
+			return err
+		}
+	}
+
+	insertTypes := func(clubID string, types []v1.NotificationType) error {
+		for i, t := range types {
+			_, err := s.txExec(ctx, tx, "INSERT INTO disabled_notification_types (user_id, club_id, position, type) VALUES (?, ?, ?, ?)",
+				prefs.UserId, clubID, i, int32(t))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := insertTypes("", prefs.DisabledTypes); err != nil {
+		return err
+	}
+	for i, club := range prefs.Clubs {
+		_, err := s.txExec(ctx, tx, "INSERT INTO club_notification_preferences (user_id, club_id, position, muted) VALUES (?, ?, ?, ?)",
+			prefs.UserId, club.ClubId, i, club.Muted)
+		if err != nil {
+			return err
+		}
+		if err := insertTypes(club.ClubId, club.DisabledTypes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CalendarFeed operations
+
+func (s *sqlStorage) GetCalendarFeed(ctx context.Context, userID string) (*v1.CalendarFeed, error) {
+	return s.getCalendarFeed(ctx, "SELECT user_id, token, created_at FROM calendar_feeds WHERE user_id = ?", userID,
+		notFound("calendar feed", userID))
+}
+
+func (s *sqlStorage) GetCalendarFeedByToken(ctx context.Context, token string) (*v1.CalendarFeed, error) {
+	return s.getCalendarFeed(ctx, "SELECT user_id, token, created_at FROM calendar_feeds WHERE token = ?", token,
+		newError(ErrNotFound, "calendar feed %s", ErrNotFound))
+}
+
+func (s *sqlStorage) getCalendarFeed(ctx context.Context, query string, arg string, notFound error) (*v1.CalendarFeed, error) {
+	feed := &v1.CalendarFeed{}
+	var createdAt sql.NullInt64
+	err := s.queryRow(ctx, query, arg).Scan(&feed.UserId, &feed.Token, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, notFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calendar feed: %w", err)
+	}
+	feed.CreatedAt = timestampFromSQL(createdAt)
+
+	return feed, nil
+}
+
+func (s *sqlStorage) PutCalendarFeed(ctx context.Context, feed *v1.CalendarFeed) error {
+	_, err := s.exec(ctx, `INSERT INTO calendar_feeds (user_id, token, created_at) VALUES (?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET token = excluded.token, created_at = excluded.created_at`,
+		feed.UserId, feed.Token, sqlTime(feed.CreatedAt))
+	if err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	return nil
+}
+
+// AppPassword operations
+
+const appPasswordColumns = "id, user_id, name, password_hash, created_at, last_used_at"
+
+func (s *sqlStorage) CreateAppPassword(ctx context.Context, appPassword *v1.AppPassword) error {
+	_, err := s.exec(ctx, "INSERT INTO app_passwords ("+appPasswordColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		appPassword.Id, appPassword.UserId, appPassword.Name, appPassword.PasswordHash,
+		sqlTime(appPassword.CreatedAt), sqlTime(appPassword.LastUsedAt))
+	if err != nil {
+		return fmt.Errorf("failed to insert app password: %w", err)
+	}
+
+	return nil
+}
+
+func (s *sqlStorage) ListAppPasswords(ctx context.Context, userID string) ([]*v1.AppPassword, error) {
+	rows, err := s.query(ctx, "SELECT "+appPasswordColumns+" FROM app_passwords WHERE user_id = ? ORDER BY created_at NULLS FIRST, id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query app passwords: %w", err)
+	}
+	defer rows.Close()
+
+	var appPasswords []*v1.AppPassword
+	for rows.Next() {
+		appPassword := &v1.AppPassword{}
+		var createdAt, lastUsedAt sql.NullInt64
+		err := rows.Scan(&appPassword.Id, &appPassword.UserId, &appPassword.Name, &appPassword.PasswordHash, &createdAt, &lastUsedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan app password: %w", err)
+		}
+		appPassword.CreatedAt = timestampFromSQL(createdAt)
+		appPassword.LastUsedAt = timestampFromSQL(lastUsedAt)
+
+		appPasswords = append(appPasswords, appPassword)
+	}
+
+	return appPasswords, rows.Err()
+}
+
+func (s *sqlStorage) PutAppPassword(ctx context.Context, appPassword *v1.AppPassword) error {
+	_, err := s.exec(ctx, `INSERT INTO app_passwords (`+appPasswordColumns+`) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET user_id = excluded.user_id, name = excluded.name, password_hash = excluded.password_hash,
+			created_at = excluded.created_at, last_used_at = excluded.last_used_at`,
+		appPassword.Id, appPassword.UserId, appPassword.Name, appPassword.PasswordHash,
+		sqlTime(appPassword.CreatedAt), sqlTime(appPassword.LastUsedAt))
+	if err != nil {
+		return fmt.Errorf("failed to save app password: %w", err)
+	}
+
+	return nil
+}
+
+func (s *sqlStorage) DeleteAppPassword(ctx context.Context, id string) error {
+	return s.deleteByID(ctx, s.db, "app_passwords", "app password", id)
+}
+
+// Consistency operations
+
+// sqlValidPicks selects the IDs of picks that aren't orphans
+const sqlValidPicks = "SELECT id FROM picks WHERE club_id IN (SELECT id FROM clubs) AND user_id IN (SELECT id FROM users)"
+
+// sqlOrphanChecks find rows that refer to rows that don't exist, in the order they're deleted.
+// The foreign keys stop new orphans, but databases from before them can still have some.
+var sqlOrphanChecks = []struct {
+	kind   string
+	table  string
+	id     string
+	where  string
+	reason string
+}{
+	{"scheduled pick", "scheduled_picks", "id",
+		"club_id NOT IN (SELECT id FROM clubs) OR pick_id NOT IN (" + sqlValidPicks + ")", "its club or pick doesn't exist"},
+	{"pick", "picks", "id", "id NOT IN (" + sqlValidPicks + ")", "its club or user doesn't exist"},
+	{"club member", "club_members", "club_id || '/' || user_id",
+		"club_id NOT IN (SELECT id FROM clubs) OR user_id NOT IN (SELECT id FROM users)", "its club or user doesn't exist"},
+	{"club notification preferences", "club_notification_preferences", "user_id || '/' || club_id",
+		"user_id NOT IN (SELECT user_id FROM notification_preferences)", "its notification preferences don't exist"},
+	{"disabled notification type", "disabled_notification_types", "user_id || '/' || club_id || '/' || position",
+		"user_id NOT IN (SELECT user_id FROM notification_preferences)", "its notification preferences don't exist"},
+	{"notification preferences", "notification_preferences", "user_id", "user_id NOT IN (SELECT id FROM users)", "its user doesn't exist"},
+	{"calendar feed", "calendar_feeds", "user_id", "user_id NOT IN (SELECT id FROM users)", "its user doesn't exist"},
+	{"app password", "app_passwords", "id", "user_id NOT IN (SELECT id FROM users)", "its user doesn't exist"},
+}
+
+func (s *sqlStorage) CheckConsistency(ctx context.Context, repair bool) ([]Orphan, error) {
+	var orphans []Orphan
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, check := range sqlOrphanChecks {
+			rows, err := tx.QueryContext(ctx, "SELECT "+check.id+" FROM "+check.table+" WHERE "+check.where+" ORDER BY 1")
+			if err != nil {
+				return fmt.Errorf("failed to find orphaned %s rows: %w", check.table, err)
+			}
+			for rows.Next() {
+				orphan := Orphan{Kind: check.kind, Reason: check.reason}
+				if err := rows.Scan(&orphan.ID); err != nil {
+					rows.Close()
+					return fmt.Errorf("failed to scan orphaned %s row: %w", check.table, err)
+				}
+				orphans = append(orphans, orphan)
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return fmt.Errorf("failed to find orphaned %s rows: %w", check.table, err)
+			}
+
+			if repair {
+				if _, err := s.txExec(ctx, tx, "DELETE FROM "+check.table+" WHERE "+check.where); err != nil {
+					return fmt.Errorf("failed to delete orphaned %s rows: %w", check.table, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orphans, nil
+}
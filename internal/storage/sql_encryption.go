@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cartermckinnon/watchclub/internal/keyring"
+)
+
+// emailIndexSchema adds the blind index that encrypted email addresses are looked up and kept unique by.
+// It's NULL for addresses stored in plain text, which the UNIQUE constraint on email covers instead.
+const emailIndexSchema = `
+ALTER TABLE users ADD COLUMN email_index TEXT;
+CREATE UNIQUE INDEX idx_users_email_index ON users(email_index);
+`
+
+// emailIndexContext is the context of email addresses' blind indexes
+const emailIndexContext = "users.email"
+
+// emailContext is the context an address is encrypted with, which ties it to its user
+func emailContext(userID string) string {
+	return "users.email:" + userID
+}
+
+func (s *sqlStorage) SetKeyring(keys *keyring.Keyring) {
+	s.keys = keys
+}
+
+// sealEmail returns the email column's value for a user's address and its blind index,
+// which are the address and NULL if there are no keys
+func (s *sqlStorage) sealEmail(userID string, email string) (string, any, error) {
+	if s.keys == nil {
+		return email, nil, nil
+	}
+	sealed, err := s.keys.Encrypt(email, emailContext(userID))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt email: %w", err)
+	}
+	return sealed, s.keys.Index(email, emailIndexContext), nil
+}
+
+// openEmail returns the address in a user's email column, decrypting it if it's encrypted
+func (s *sqlStorage) openEmail(userID string, email string) (string, error) {
+	if _, encrypted := keyring.KeyID(email); !encrypted {
+		return email, nil
+	}
+	if s.keys == nil {
+		return "", fmt.Errorf("email of user %s is encrypted, but no keys were provided", userID)
+	}
+	email, err := s.keys.Decrypt(email, emailContext(userID))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt email of user %s: %w", userID, err)
+	}
+	return email, nil
+}
+
+func (s *sqlStorage) RotateKeys(ctx context.Context) (int, error) {
+	if s.keys == nil {
+		return 0, fmt.Errorf("no keys were provided")
+	}
+
+	rotated := 0
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		type userEmail struct {
+			id, email  string
+			emailIndex sql.NullString
+		}
+		rows, err := tx.QueryContext(ctx, "SELECT id, email, email_index FROM users ORDER BY id")
+		if err != nil {
+			return fmt.Errorf("failed to query users: %w", err)
+		}
+		var users []userEmail
+		for rows.Next() {
+			var user userEmail
+			if err := rows.Scan(&user.id, &user.email, &user.emailIndex); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan user: %w", err)
+			}
+			users = append(users, user)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to query users: %w", err)
+		}
+
+		for _, user := range users {
+			email, err := s.openEmail(user.id, user.email)
+			if err != nil {
+				return err
+			}
+			keyID, _ := keyring.KeyID(user.email)
+			if keyID == s.keys.Current() && user.emailIndex.String == s.keys.Index(email, emailIndexContext) {
+				continue
+			}
+
+			sealed, emailIndex, err := s.sealEmail(user.id, email)
+			if err != nil {
+				return err
+			}
+			if _, err := s.txExec(ctx, tx, "UPDATE users SET email = ?, email_index = ? WHERE id = ?", sealed, emailIndex, user.id); err != nil {
+				return fmt.Errorf("failed to update user %s: %w", user.id, err)
+			}
+			rotated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rotated, nil
+}
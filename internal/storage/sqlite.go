@@ -3,415 +3,257 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
-	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
-	_ "modernc.org/sqlite"
-	"google.golang.org/protobuf/proto"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 )
 
-// NewSQLiteStorage creates a new SQLite storage implementation
-func NewSQLiteStorage(dbPath string) (Storage, error) {
-	db, err := sql.Open("sqlite", dbPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
-
-	// Create tables if they don't exist
-	if err := initSchema(db); err != nil {
-		db.Close()
-		return nil, fmt.Errorf("failed to initialize schema: %w", err)
-	}
-
-	return &sqliteStorage{db: db}, nil
-}
-
-type sqliteStorage struct {
-	db *sql.DB
-}
-
-func initSchema(db *sql.DB) error {
-	schema := `
-	CREATE TABLE IF NOT EXISTS users (
-		id TEXT PRIMARY KEY,
-		data BLOB NOT NULL
-	);
-
-	CREATE TABLE IF NOT EXISTS clubs (
-		id TEXT PRIMARY KEY,
-		data BLOB NOT NULL
-	);
-
-	CREATE TABLE IF NOT EXISTS picks (
-		id TEXT PRIMARY KEY,
-		club_id TEXT NOT NULL,
-		data BLOB NOT NULL
-	);
-	CREATE INDEX IF NOT EXISTS idx_picks_club_id ON picks(club_id);
+// SQLite defaults, which can be overridden with the path's query parameters
+const (
+	defaultSQLiteJournalMode  = "WAL"
+	defaultSQLiteBusyTimeout  = 5 * time.Second
+	defaultSQLiteMaxOpenConns = 4
+)
 
-	CREATE TABLE IF NOT EXISTS scheduled_picks (
-		id TEXT PRIMARY KEY,
-		club_id TEXT NOT NULL,
-		data BLOB NOT NULL
-	);
-	CREATE INDEX IF NOT EXISTS idx_scheduled_picks_club_id ON scheduled_picks(club_id);
-	`
+// sqliteJournalModes and sqliteSynchronousModes are the values SQLite accepts for those pragmas
+var (
+	sqliteJournalModes     = []string{"DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF"}
+	sqliteSynchronousModes = []string{"OFF", "NORMAL", "FULL", "EXTRA"}
+)
 
-	_, err := db.Exec(schema)
-	return err
+// sqliteOptions are the settings parsed from a SQLite path's query parameters
+type sqliteOptions struct {
+	journalMode  string
+	synchronous  string
+	busyTimeout  time.Duration
+	maxOpenConns int
+	// driver is the rest of the query, which is passed to the driver
+	driver url.Values
 }
 
-// User operations
-
-func (s *sqliteStorage) CreateUser(ctx context.Context, user *v1.User) error {
-	data, err := proto.Marshal(user)
-	if err != nil {
-		return fmt.Errorf("failed to marshal user: %w", err)
-	}
-
-	_, err = s.db.ExecContext(ctx, "INSERT INTO users (id, data) VALUES (?, ?)", user.Id, data)
+// NewSQLiteStorage creates a new SQLite storage implementation.
+// The path can have these query parameters, e.g. path/to/watchclub.db?synchronous=NORMAL&busy_timeout=10s:
+// - journal_mode (default WAL)
+// - synchronous (default FULL)
+// - busy_timeout, how long to wait for a lock (default 5s)
+// - max_open_conns, the number of connections for reads (default 4)
+//
+// Any other parameters are passed to the driver.
+//
+// Writes share a single connection, so they wait for each other in the pool rather than failing with SQLITE_BUSY,
+// and reads have a separate pool of read-only connections, which WAL lets carry on while a write is in progress.
+func NewSQLiteStorage(dbPath string) (Storage, error) {
+	dbPath, rawQuery, _ := strings.Cut(dbPath, "?")
+	opts, err := parseSQLiteOptions(rawQuery)
 	if err != nil {
-		return fmt.Errorf("failed to insert user: %w", err)
+		return nil, err
 	}
 
-	return nil
-}
-
-func (s *sqliteStorage) GetUser(ctx context.Context, id string) (*v1.User, error) {
-	var data []byte
-	err := s.db.QueryRowContext(ctx, "SELECT data FROM users WHERE id = ?", id).Scan(&data)
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found: %s", id)
-	}
+	// Transactions take the write lock up front, so that they can't deadlock upgrading from a read lock
+	db, err := sql.Open("sqlite", dbPath+"?"+opts.dsn("_txlock=immediate"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user: %w", err)
+		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	db.SetMaxOpenConns(1)
 
-	user := &v1.User{}
-	if err := proto.Unmarshal(data, user); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal user: %w", err)
+	// Create or upgrade the schema
+	if err := migrateSQLite(context.Background(), db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate schema: %w", err)
 	}
 
-	return user, nil
-}
-
-func (s *sqliteStorage) GetUserByEmail(ctx context.Context, email string) (*v1.User, error) {
-	rows, err := s.db.QueryContext(ctx, "SELECT data FROM users")
-	if err != nil {
-		return nil, fmt.Errorf("failed to query users: %w", err)
+	// Pragmas that can't be applied are ignored by SQLite, so check that they were
+	if err := opts.check(context.Background(), db); err != nil {
+		db.Close()
+		return nil, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var data []byte
-		if err := rows.Scan(&data); err != nil {
-			continue
-		}
 
-		user := &v1.User{}
-		if err := proto.Unmarshal(data, user); err != nil {
-			continue
-		}
-
-		if user.Email == email {
-			return user, nil
-		}
+	// An in-memory database is private to its connection, so it can't have a separate pool for reads
+	if dbPath == ":memory:" || opts.driver.Get("mode") == "memory" {
+		return &sqlStorage{db: db}, nil
 	}
 
-	return nil, fmt.Errorf("user not found with email: %s", email)
-}
-
-func (s *sqliteStorage) ListUsers(ctx context.Context) ([]*v1.User, error) {
-	rows, err := s.db.QueryContext(ctx, "SELECT data FROM users")
+	reader, err := sql.Open("sqlite", dbPath+"?"+opts.dsn("_pragma=query_only(1)"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to query users: %w", err)
-	}
-	defer rows.Close()
-
-	var users []*v1.User
-	for rows.Next() {
-		var data []byte
-		if err := rows.Scan(&data); err != nil {
-			return nil, fmt.Errorf("failed to scan user: %w", err)
-		}
-
-		user := &v1.User{}
-		if err := proto.Unmarshal(data, user); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal user: %w", err)
-		}
-
-		users = append(users, user)
+		db.Close()
+		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	reader.SetMaxOpenConns(opts.maxOpenConns)
+	reader.SetMaxIdleConns(opts.maxOpenConns)
 
-	return users, nil
+	return &sqlStorage{db: db, reader: reader}, nil
 }
 
-func (s *sqliteStorage) DeleteUser(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", id)
+// parseSQLiteOptions validates a SQLite path's query parameters
+func parseSQLiteOptions(rawQuery string) (sqliteOptions, error) {
+	query, err := url.ParseQuery(rawQuery)
 	if err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
+		return sqliteOptions{}, fmt.Errorf("invalid sqlite options: %w", err)
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+	opts := sqliteOptions{
+		journalMode: defaultSQLiteJournalMode,
+		busyTimeout: defaultSQLiteBusyTimeout,
+		driver:      query,
 	}
-
-	if rows == 0 {
-		return fmt.Errorf("user not found: %s", id)
-	}
-
-	return nil
-}
-
-// Club operations
-
-func (s *sqliteStorage) CreateClub(ctx context.Context, club *v1.Club) error {
-	data, err := proto.Marshal(club)
-	if err != nil {
-		return fmt.Errorf("failed to marshal club: %w", err)
-	}
-
-	_, err = s.db.ExecContext(ctx, "INSERT INTO clubs (id, data) VALUES (?, ?)", club.Id, data)
-	if err != nil {
-		return fmt.Errorf("failed to insert club: %w", err)
+	if value := query.Get("journal_mode"); value != "" {
+		if opts.journalMode, err = enumParam("journal_mode", value, sqliteJournalModes); err != nil {
+			return sqliteOptions{}, err
+		}
 	}
-
-	return nil
-}
-
-func (s *sqliteStorage) GetClub(ctx context.Context, id string) (*v1.Club, error) {
-	var data []byte
-	err := s.db.QueryRowContext(ctx, "SELECT data FROM clubs WHERE id = ?", id).Scan(&data)
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("club not found: %s", id)
+	if value := query.Get("synchronous"); value != "" {
+		if opts.synchronous, err = enumParam("synchronous", value, sqliteSynchronousModes); err != nil {
+			return sqliteOptions{}, err
+		}
 	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to query club: %w", err)
+	if value := query.Get("busy_timeout"); value != "" {
+		if opts.busyTimeout, err = time.ParseDuration(value); err != nil || opts.busyTimeout < 0 {
+			return sqliteOptions{}, fmt.Errorf("invalid busy_timeout: %s", value)
+		}
 	}
-
-	club := &v1.Club{}
-	if err := proto.Unmarshal(data, club); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal club: %w", err)
+	if opts.maxOpenConns, err = intParam(query, "max_open_conns", defaultSQLiteMaxOpenConns); err != nil {
+		return sqliteOptions{}, err
 	}
-
-	return club, nil
-}
-
-func (s *sqliteStorage) ListClubs(ctx context.Context) ([]*v1.Club, error) {
-	rows, err := s.db.QueryContext(ctx, "SELECT data FROM clubs")
-	if err != nil {
-		return nil, fmt.Errorf("failed to query clubs: %w", err)
+	if opts.maxOpenConns == 0 {
+		return sqliteOptions{}, fmt.Errorf("invalid max_open_conns: must be at least 1")
 	}
-	defer rows.Close()
-
-	var clubs []*v1.Club
-	for rows.Next() {
-		var data []byte
-		if err := rows.Scan(&data); err != nil {
-			return nil, fmt.Errorf("failed to scan club: %w", err)
-		}
-
-		club := &v1.Club{}
-		if err := proto.Unmarshal(data, club); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal club: %w", err)
-		}
-
-		clubs = append(clubs, club)
+	for _, name := range []string{"journal_mode", "synchronous", "busy_timeout", "max_open_conns"} {
+		query.Del(name)
 	}
-
-	return clubs, nil
+	return opts, nil
 }
 
-func (s *sqliteStorage) ListClubsForUser(ctx context.Context, userID string) ([]*v1.Club, error) {
-	// Get all clubs and filter by membership
-	// (More efficient would be to denormalize member_ids or use a join table)
-	allClubs, err := s.ListClubs(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	var userClubs []*v1.Club
-	for _, club := range allClubs {
-		for _, memberID := range club.MemberIds {
-			if memberID == userID {
-				userClubs = append(userClubs, club)
-				break
-			}
-		}
+// enumParam returns a parameter's value in upper case, if it's one of the allowed values
+func enumParam(name string, value string, allowed []string) (string, error) {
+	upper := strings.ToUpper(value)
+	if !slices.Contains(allowed, upper) {
+		return "", fmt.Errorf("invalid %s: %s (supported: %s)", name, value, strings.Join(allowed, ", "))
 	}
-
-	return userClubs, nil
+	return upper, nil
 }
 
-func (s *sqliteStorage) DeleteClub(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM clubs WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete club: %w", err)
+// dsn returns the query for the driver, which applies the pragmas to every connection in the pool
+func (o sqliteOptions) dsn(extra string) string {
+	query := url.Values{}
+	for name, values := range o.driver {
+		query[name] = slices.Clone(values)
 	}
-
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", o.busyTimeout.Milliseconds()))
+	query.Add("_pragma", "journal_mode("+o.journalMode+")")
+	if o.synchronous != "" {
+		query.Add("_pragma", "synchronous("+o.synchronous+")")
 	}
-
-	if rows == 0 {
-		return fmt.Errorf("club not found: %s", id)
-	}
-
-	return nil
+	return query.Encode() + "&" + extra
 }
 
-// Pick operations
-
-func (s *sqliteStorage) CreatePick(ctx context.Context, pick *v1.Pick) error {
-	data, err := proto.Marshal(pick)
-	if err != nil {
-		return fmt.Errorf("failed to marshal pick: %w", err)
+// check returns an error if the database's journal mode isn't the one that was asked for,
+// which happens when the database or file system doesn't support it
+func (o sqliteOptions) check(ctx context.Context, db *sql.DB) error {
+	var journalMode string
+	if err := db.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&journalMode); err != nil {
+		return fmt.Errorf("failed to check journal mode: %w", err)
 	}
-
-	_, err = s.db.ExecContext(ctx, "INSERT INTO picks (id, club_id, data) VALUES (?, ?, ?)", pick.Id, pick.ClubId, data)
-	if err != nil {
-		return fmt.Errorf("failed to insert pick: %w", err)
+	if !strings.EqualFold(journalMode, o.journalMode) {
+		return fmt.Errorf("failed to set journal_mode to %s: database is using %s", o.journalMode, journalMode)
 	}
-
 	return nil
 }
 
-func (s *sqliteStorage) GetPick(ctx context.Context, id string) (*v1.Pick, error) {
-	var data []byte
-	err := s.db.QueryRowContext(ctx, "SELECT data FROM picks WHERE id = ?", id).Scan(&data)
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("pick not found: %s", id)
+// Backup copies the database to a new file with VACUUM INTO, which reads a consistent snapshot without blocking writers
+func (s *sqlStorage) Backup(ctx context.Context, path string) error {
+	if s.postgres {
+		return errors.New("backups are only supported for SQLite; use pg_dump to back up PostgreSQL")
 	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to query pick: %w", err)
-	}
-
-	pick := &v1.Pick{}
-	if err := proto.Unmarshal(data, pick); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal pick: %w", err)
+	if s.reader == nil {
+		if _, err := s.db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+			return fmt.Errorf("failed to back up database: %w", err)
+		}
+		return nil
 	}
 
-	return pick, nil
-}
-
-func (s *sqliteStorage) ListPicks(ctx context.Context, clubID string) ([]*v1.Pick, error) {
-	rows, err := s.db.QueryContext(ctx, "SELECT data FROM picks WHERE club_id = ?", clubID)
+	// Back up on a read connection so that writes can carry on, which needs query_only turned off while it writes the copy
+	conn, err := s.reader.Conn(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query picks: %w", err)
+		return fmt.Errorf("failed to back up database: %w", err)
 	}
-	defer rows.Close()
-
-	var picks []*v1.Pick
-	for rows.Next() {
-		var data []byte
-		if err := rows.Scan(&data); err != nil {
-			return nil, fmt.Errorf("failed to scan pick: %w", err)
-		}
-
-		pick := &v1.Pick{}
-		if err := proto.Unmarshal(data, pick); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal pick: %w", err)
-		}
-
-		picks = append(picks, pick)
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "PRAGMA query_only = 0"); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
 	}
-
-	return picks, nil
-}
-
-func (s *sqliteStorage) DeletePick(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM picks WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete pick: %w", err)
+	_, err = conn.ExecContext(ctx, "VACUUM INTO ?", path)
+	if _, resetErr := conn.ExecContext(context.Background(), "PRAGMA query_only = 1"); resetErr != nil {
+		// don't return a connection that can write to the pool
+		conn.Raw(func(any) error { return driver.ErrBadConn })
 	}
-
-	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rows == 0 {
-		return fmt.Errorf("pick not found: %s", id)
+		return fmt.Errorf("failed to back up database: %w", err)
 	}
-
 	return nil
 }
 
-// ScheduledPick operations
-
-func (s *sqliteStorage) CreateScheduledPick(ctx context.Context, assignment *v1.ScheduledPick) error {
-	data, err := proto.Marshal(assignment)
+// OpenSQLiteBackuper opens an existing SQLite database to back it up, without creating it or migrating its schema,
+// so that a database in use by a server of another version is left as it is
+func OpenSQLiteBackuper(ctx context.Context, path string) (BackupCloser, error) {
+	// mode=rw fails rather than creating a database that doesn't exist
+	db, err := sql.Open("sqlite", "file:"+path+fmt.Sprintf("?mode=rw&_pragma=busy_timeout(%d)", defaultSQLiteBusyTimeout.Milliseconds()))
 	if err != nil {
-		return fmt.Errorf("failed to marshal scheduled pick: %w", err)
+		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	db.SetMaxOpenConns(1)
 
-	_, err = s.db.ExecContext(ctx, "INSERT INTO scheduled_picks (id, club_id, data) VALUES (?, ?, ?)", assignment.Id, assignment.ClubId, data)
-	if err != nil {
-		return fmt.Errorf("failed to insert scheduled pick: %w", err)
+	var version int
+	if err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("not a watchclub database: %w", err)
 	}
-
-	return nil
+	return &sqlStorage{db: db}, nil
 }
 
-func (s *sqliteStorage) GetScheduledPick(ctx context.Context, id string) (*v1.ScheduledPick, error) {
-	var data []byte
-	err := s.db.QueryRowContext(ctx, "SELECT data FROM scheduled_picks WHERE id = ?", id).Scan(&data)
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("scheduled pick not found: %s", id)
-	}
+// CheckSQLiteFile checks that a file is an intact SQLite database with a watchclub schema, without changing it
+func CheckSQLiteFile(ctx context.Context, path string) error {
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
 	if err != nil {
-		return nil, fmt.Errorf("failed to query scheduled pick: %w", err)
+		return fmt.Errorf("failed to open database: %w", err)
 	}
+	defer db.Close()
 
-	assignment := &v1.ScheduledPick{}
-	if err := proto.Unmarshal(data, assignment); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal scheduled pick: %w", err)
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("failed to check database integrity: %w", err)
 	}
-
-	return assignment, nil
-}
-
-func (s *sqliteStorage) ListScheduledPicks(ctx context.Context, clubID string) ([]*v1.ScheduledPick, error) {
-	rows, err := s.db.QueryContext(ctx, "SELECT data FROM scheduled_picks WHERE club_id = ?", clubID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query scheduled picks: %w", err)
+	if result != "ok" {
+		return fmt.Errorf("database is corrupt: %s", result)
 	}
-	defer rows.Close()
 
-	var assignments []*v1.ScheduledPick
-	for rows.Next() {
-		var data []byte
-		if err := rows.Scan(&data); err != nil {
-			return nil, fmt.Errorf("failed to scan scheduled pick: %w", err)
-		}
-
-		assignment := &v1.ScheduledPick{}
-		if err := proto.Unmarshal(data, assignment); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal scheduled pick: %w", err)
-		}
-
-		assignments = append(assignments, assignment)
+	var version int
+	if err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return fmt.Errorf("not a watchclub database: %w", err)
 	}
-
-	return assignments, nil
-}
-
-func (s *sqliteStorage) DeleteScheduledPick(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM scheduled_picks WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete scheduled pick: %w", err)
+	if latest := sqliteMigrations[len(sqliteMigrations)-1].version; version > latest {
+		return fmt.Errorf("database schema version %d is newer than this version of watchclub supports (%d)", version, latest)
 	}
+	return nil
+}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+// sqliteConstraintError returns the Storage error for a violated constraint, or nil for other errors
+func sqliteConstraintError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return nil
 	}
-
-	if rows == 0 {
-		return fmt.Errorf("scheduled pick not found: %s", id)
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return ErrAlreadyExists
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return ErrConflict
 	}
-
 	return nil
 }
@@ -0,0 +1,481 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var sqliteMigrations = []sqlMigration{
+	{version: 1, name: "blob tables", up: execMigration(blobSchema)},
+	{version: 2, name: "columns", up: migrateBlobsToColumns},
+	{version: 3, name: "email index", up: execMigration(emailIndexSchema)},
+	{version: 4, name: "soft delete", up: execMigration(sqliteSoftDeleteSchema)},
+}
+
+// sqliteSoftDeleteSchema adds the time clubs and picks were deleted, which is NULL until they are
+const sqliteSoftDeleteSchema = `
+ALTER TABLE clubs ADD COLUMN deleted_at INTEGER;
+ALTER TABLE picks ADD COLUMN deleted_at INTEGER;
+`
+
+// migrateSQLite applies the migrations that haven't been applied to the database yet
+func migrateSQLite(ctx context.Context, db *sql.DB) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Migrations may rebuild tables, which foreign key enforcement gets in the way of
+	// (https://www.sqlite.org/lang_altertable.html#otheralter), so the constraints are checked before each commit instead.
+	// The pragma can't be changed inside a transaction.
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "PRAGMA foreign_keys = ON")
+
+	_, err = conn.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	var current int
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current); err != nil {
+		return fmt.Errorf("failed to query schema version: %w", err)
+	}
+	latest := sqliteMigrations[len(sqliteMigrations)-1].version
+	if current > latest {
+		return fmt.Errorf("database schema version %d is newer than the latest known version %d", current, latest)
+	}
+
+	for _, migration := range sqliteMigrations {
+		if migration.version <= current {
+			continue
+		}
+		if err := applySQLiteMigration(ctx, conn, migration); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", migration.version, migration.name, err)
+		}
+	}
+
+	return nil
+}
+
+func applySQLiteMigration(ctx context.Context, conn *sql.Conn, migration sqlMigration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := migration.up(ctx, tx); err != nil {
+		return err
+	}
+
+	var table, parent string
+	var rowID sql.NullInt64
+	var fkID int
+	err = tx.QueryRowContext(ctx, "PRAGMA foreign_key_check").Scan(&table, &rowID, &parent, &fkID)
+	if err == nil {
+		return fmt.Errorf("row %d of %s references a missing row of %s", rowID.Int64, table, parent)
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+		migration.version, migration.name, time.Now().UnixNano())
+	if err != nil {
+		return fmt.Errorf("failed to record migration: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// blobSchema is the original schema, which stored each entity as a protobuf blob.
+// Databases created before migrations existed already have these tables.
+const blobSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id TEXT PRIMARY KEY,
+	data BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS clubs (
+	id TEXT PRIMARY KEY,
+	data BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL,
+	data BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_picks_club_id ON picks(club_id);
+
+CREATE TABLE IF NOT EXISTS scheduled_picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL,
+	data BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_scheduled_picks_club_id ON scheduled_picks(club_id);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+	user_id TEXT PRIMARY KEY,
+	data BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+	user_id TEXT PRIMARY KEY,
+	token TEXT NOT NULL UNIQUE,
+	data BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS app_passwords (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL,
+	data BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_app_passwords_user_id ON app_passwords(user_id);
+`
+
+// columnSchema stores each field in its own column. Timestamps are Unix nanoseconds, or NULL if unset.
+const columnSchema = `
+CREATE TABLE users (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	created_at INTEGER
+);
+
+CREATE TABLE clubs (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	start_date INTEGER,
+	started INTEGER NOT NULL DEFAULT 0,
+	created_at INTEGER,
+	updated_at INTEGER,
+	max_picks_per_member INTEGER NOT NULL DEFAULT 0,
+	schedule_interval_quantity INTEGER NOT NULL DEFAULT 0,
+	schedule_interval_unit INTEGER NOT NULL DEFAULT 0
+);
+
+-- position keeps members in the order they joined
+CREATE TABLE club_members (
+	club_id TEXT NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	PRIMARY KEY (club_id, user_id)
+);
+
+CREATE TABLE picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL REFERENCES clubs(id),
+	user_id TEXT NOT NULL REFERENCES users(id),
+	title TEXT NOT NULL,
+	year INTEGER NOT NULL DEFAULT 0,
+	notes TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	created_at INTEGER
+);
+
+CREATE TABLE scheduled_picks (
+	id TEXT PRIMARY KEY,
+	club_id TEXT NOT NULL REFERENCES clubs(id),
+	pick_id TEXT NOT NULL REFERENCES picks(id),
+	sequence_number INTEGER NOT NULL,
+	start_date INTEGER,
+	calendar_uid TEXT NOT NULL DEFAULT '',
+	calendar_sequence INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE notification_preferences (
+	user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	unsubscribed_all INTEGER NOT NULL DEFAULT 0,
+	digest_weekday INTEGER NOT NULL DEFAULT 0,
+	updated_at INTEGER,
+	last_digest_sent_at INTEGER
+);
+
+-- club_id isn't a foreign key, because unsubscribe links can outlive their club
+CREATE TABLE club_notification_preferences (
+	user_id TEXT NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+	club_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	muted INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (user_id, club_id)
+);
+
+-- club_id is empty for types disabled for all clubs
+CREATE TABLE disabled_notification_types (
+	user_id TEXT NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+	club_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	type INTEGER NOT NULL,
+	PRIMARY KEY (user_id, club_id, position)
+);
+
+CREATE TABLE calendar_feeds (
+	user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	token TEXT NOT NULL UNIQUE,
+	created_at INTEGER
+);
+
+CREATE TABLE app_passwords (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	password_hash TEXT NOT NULL,
+	created_at INTEGER,
+	last_used_at INTEGER
+);
+`
+
+// columnIndexes are created once the blob tables (and their indexes) are gone
+const columnIndexes = `
+CREATE INDEX idx_club_members_user_id ON club_members(user_id);
+CREATE INDEX idx_picks_club_id ON picks(club_id);
+CREATE INDEX idx_picks_user_id ON picks(user_id);
+CREATE INDEX idx_scheduled_picks_club_id ON scheduled_picks(club_id);
+CREATE INDEX idx_scheduled_picks_pick_id ON scheduled_picks(pick_id);
+CREATE INDEX idx_app_passwords_user_id ON app_passwords(user_id);
+`
+
+// deleteOrphans removes rows that the blob tables couldn't stop from referring to deleted rows
+const deleteOrphans = `
+DELETE FROM club_members WHERE club_id NOT IN (SELECT id FROM clubs) OR user_id NOT IN (SELECT id FROM users);
+DELETE FROM picks WHERE club_id NOT IN (SELECT id FROM clubs) OR user_id NOT IN (SELECT id FROM users);
+DELETE FROM scheduled_picks WHERE club_id NOT IN (SELECT id FROM clubs) OR pick_id NOT IN (SELECT id FROM picks);
+DELETE FROM notification_preferences WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM club_notification_preferences WHERE user_id NOT IN (SELECT user_id FROM notification_preferences);
+DELETE FROM disabled_notification_types WHERE user_id NOT IN (SELECT user_id FROM notification_preferences);
+DELETE FROM calendar_feeds WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM app_passwords WHERE user_id NOT IN (SELECT id FROM users);
+`
+
+var blobTables = []string{"users", "clubs", "picks", "scheduled_picks", "notification_preferences", "calendar_feeds", "app_passwords"}
+
+// checkUniqueEmails finds users that share an email, which the blob tables allowed but the users table doesn't,
+// so the migration can stop with the users that need fixing instead of a bare constraint error
+func checkUniqueEmails(users []*v1.User) error {
+	ids := map[string][]string{}
+	for _, user := range users {
+		ids[user.Email] = append(ids[user.Email], user.Id)
+	}
+	var duplicates []string
+	for _, email := range slices.Sorted(maps.Keys(ids)) {
+		if len(ids[email]) > 1 {
+			slices.Sort(ids[email])
+			duplicates = append(duplicates, fmt.Sprintf("%s (users %s)", email, strings.Join(ids[email], ", ")))
+		}
+	}
+	if len(duplicates) > 0 {
+		return fmt.Errorf("users must have unique emails; give all but one of these users another email and try again: %s",
+			strings.Join(duplicates, "; "))
+	}
+	return nil
+}
+
+// migrateBlobsToColumns moves every entity out of its protobuf blob and into columns
+func migrateBlobsToColumns(ctx context.Context, tx *sql.Tx) error {
+	for _, table := range blobTables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO blob_%s", table, table)); err != nil {
+			return fmt.Errorf("failed to rename %s: %w", table, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, columnSchema); err != nil {
+		return fmt.Errorf("failed to create tables: %w", err)
+	}
+
+	users, err := readBlobs(ctx, tx, "blob_users", func() *v1.User { return &v1.User{} })
+	if err != nil {
+		return err
+	}
+	if err := checkUniqueEmails(users); err != nil {
+		return err
+	}
+	for _, user := range users {
+		_, err := tx.ExecContext(ctx, "INSERT INTO users (id, name, email, created_at) VALUES (?, ?, ?, ?)",
+			user.Id, user.Name, user.Email, sqlTime(user.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to migrate user %s: %w", user.Id, err)
+		}
+	}
+
+	clubs, err := readBlobs(ctx, tx, "blob_clubs", func() *v1.Club { return &v1.Club{} })
+	if err != nil {
+		return err
+	}
+	for _, club := range clubs {
+		_, err := tx.ExecContext(ctx, `INSERT INTO clubs (id, name, start_date, started, created_at, updated_at,
+			max_picks_per_member, schedule_interval_quantity, schedule_interval_unit) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			club.Id, club.Name, sqlTime(club.StartDate), club.Started, sqlTime(club.CreatedAt), sqlTime(club.UpdatedAt),
+			club.MaxPicksPerMember, club.ScheduleIntervalQuantity, int32(club.ScheduleIntervalUnit))
+		if err != nil {
+			return fmt.Errorf("failed to migrate club %s: %w", club.Id, err)
+		}
+		for i, memberID := range club.MemberIds {
+			_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO club_members (club_id, user_id, position) VALUES (?, ?, ?)", club.Id, memberID, i)
+			if err != nil {
+				return fmt.Errorf("failed to migrate members of club %s: %w", club.Id, err)
+			}
+		}
+	}
+
+	picks, err := readBlobs(ctx, tx, "blob_picks", func() *v1.Pick { return &v1.Pick{} })
+	if err != nil {
+		return err
+	}
+	insertPick := func(pick *v1.Pick) error {
+		_, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO picks (id, club_id, user_id, title, year, notes, link, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			pick.Id, pick.ClubId, pick.UserId, pick.Title, pick.Year, pick.Notes, pick.Link, sqlTime(pick.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to migrate pick %s: %w", pick.Id, err)
+		}
+		return nil
+	}
+	for _, pick := range picks {
+		if err := insertPick(pick); err != nil {
+			return err
+		}
+	}
+
+	assignments, err := readBlobs(ctx, tx, "blob_scheduled_picks", func() *v1.ScheduledPick { return &v1.ScheduledPick{} })
+	if err != nil {
+		return err
+	}
+	for _, assignment := range assignments {
+		if assignment.Pick == nil {
+			continue
+		}
+		// scheduled picks carried a copy of their pick, which may be all that's left of it
+		if err := insertPick(assignment.Pick); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO scheduled_picks (id, club_id, pick_id, sequence_number, start_date, calendar_uid, calendar_sequence)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			assignment.Id, assignment.ClubId, assignment.Pick.Id, assignment.SequenceNumber, sqlTime(assignment.StartDate),
+			assignment.CalendarUid, assignment.CalendarSequence)
+		if err != nil {
+			return fmt.Errorf("failed to migrate scheduled pick %s: %w", assignment.Id, err)
+		}
+	}
+
+	allPrefs, err := readBlobs(ctx, tx, "blob_notification_preferences", func() *v1.NotificationPreferences { return &v1.NotificationPreferences{} })
+	if err != nil {
+		return err
+	}
+	for _, prefs := range allPrefs {
+		if err := migrateNotificationPreferences(ctx, tx, prefs); err != nil {
+			return fmt.Errorf("failed to migrate notification preferences of %s: %w", prefs.UserId, err)
+		}
+	}
+
+	feeds, err := readBlobs(ctx, tx, "blob_calendar_feeds", func() *v1.CalendarFeed { return &v1.CalendarFeed{} })
+	if err != nil {
+		return err
+	}
+	for _, feed := range feeds {
+		_, err := tx.ExecContext(ctx, "INSERT INTO calendar_feeds (user_id, token, created_at) VALUES (?, ?, ?)",
+			feed.UserId, feed.Token, sqlTime(feed.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to migrate calendar feed of %s: %w", feed.UserId, err)
+		}
+	}
+
+	appPasswords, err := readBlobs(ctx, tx, "blob_app_passwords", func() *v1.AppPassword { return &v1.AppPassword{} })
+	if err != nil {
+		return err
+	}
+	for _, appPassword := range appPasswords {
+		_, err := tx.ExecContext(ctx, `INSERT INTO app_passwords (id, user_id, name, password_hash, created_at, last_used_at)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			appPassword.Id, appPassword.UserId, appPassword.Name, appPassword.PasswordHash,
+			sqlTime(appPassword.CreatedAt), sqlTime(appPassword.LastUsedAt))
+		if err != nil {
+			return fmt.Errorf("failed to migrate app password %s: %w", appPassword.Id, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteOrphans); err != nil {
+		return fmt.Errorf("failed to delete orphaned rows: %w", err)
+	}
+	for _, table := range blobTables {
+		if _, err := tx.ExecContext(ctx, "DROP TABLE blob_"+table); err != nil {
+			return fmt.Errorf("failed to drop blob_%s: %w", table, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, columnIndexes); err != nil {
+		return fmt.Errorf("failed to create indexes: %w", err)
+	}
+
+	return nil
+}
+
+func migrateNotificationPreferences(ctx context.Context, tx *sql.Tx, prefs *v1.NotificationPreferences) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO notification_preferences (user_id, unsubscribed_all, digest_weekday, updated_at, last_digest_sent_at)
+		VALUES (?, ?, ?, ?, ?)`,
+		prefs.UserId, prefs.UnsubscribedAll, int32(prefs.DigestWeekday), sqlTime(prefs.UpdatedAt), sqlTime(prefs.LastDigestSentAt))
+	if err != nil {
+		return err
+	}
+	insertTypes := func(clubID string, types []v1.NotificationType) error {
+		for i, t := range types {
+			_, err := tx.ExecContext(ctx, "INSERT INTO disabled_notification_types (user_id, club_id, position, type) VALUES (?, ?, ?, ?)",
+				prefs.UserId, clubID, i, int32(t))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := insertTypes("", prefs.DisabledTypes); err != nil {
+		return err
+	}
+	for i, club := range prefs.Clubs {
+		_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO club_notification_preferences (user_id, club_id, position, muted) VALUES (?, ?, ?, ?)",
+			prefs.UserId, club.ClubId, i, club.Muted)
+		if err != nil {
+			return err
+		}
+		if err := insertTypes(club.ClubId, club.DisabledTypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBlobs unmarshals every row of a blob table
+func readBlobs[T proto.Message](ctx context.Context, tx *sql.Tx, table string, newMessage func() T) ([]T, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT data FROM "+table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var messages []T
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", table, err)
+		}
+		message := newMessage()
+		if err := proto.Unmarshal(data, message); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", table, err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
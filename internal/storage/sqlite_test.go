@@ -0,0 +1,489 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/cartermckinnon/watchclub/internal/api/v1"
+	"github.com/cartermckinnon/watchclub/internal/keyring"
+)
+
+func newTestSQLiteStorage(t *testing.T, path string) *sqlStorage {
+	store, err := NewSQLiteStorage(path)
+	require.NoError(t, err)
+	s := store.(*sqlStorage)
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func Test_SQLite_Conformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		return newTestSQLiteStorage(t, filepath.Join(t.TempDir(), "watchclub.db"))
+	})
+}
+
+func Test_SQLite_MigrateFromBlobs(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+
+	now := timestamppb.New(time.Date(2026, time.March, 1, 12, 30, 0, 123456789, time.UTC))
+	jo := &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com", CreatedAt: now}
+	sam := &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com", CreatedAt: now}
+	club := &v1.Club{
+		Id:                       "club",
+		Name:                     "Movie Night",
+		MemberIds:                []string{"sam", "jo", "gone"},
+		StartDate:                now,
+		Started:                  true,
+		CreatedAt:                now,
+		UpdatedAt:                now,
+		MaxPicksPerMember:        2,
+		ScheduleIntervalQuantity: 1,
+		ScheduleIntervalUnit:     v1.ScheduleIntervalUnit_SCHEDULE_INTERVAL_UNIT_WEEKS,
+	}
+	heat := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat", Year: 1995, Notes: "long", Link: "https://example.com", CreatedAt: now}
+	// a pick that was deleted after it was scheduled
+	alien := &v1.Pick{Id: "alien", ClubId: "club", UserId: "sam", Title: "Alien", Year: 1979, CreatedAt: now}
+	scheduled := []*v1.ScheduledPick{
+		{Id: "s1", ClubId: "club", SequenceNumber: 1, StartDate: now, Pick: heat, CalendarUid: "s1@watchclub", CalendarSequence: 2},
+		{Id: "s2", ClubId: "club", SequenceNumber: 2, StartDate: now, Pick: alien, CalendarUid: "s2@watchclub"},
+	}
+	prefs := &v1.NotificationPreferences{
+		UserId:        "jo",
+		DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_REMINDERS, v1.NotificationType_NOTIFICATION_TYPE_CLUB_STARTED},
+		Clubs: []*v1.ClubNotificationPreferences{
+			{ClubId: "club", Muted: true},
+			{ClubId: "other", DisabledTypes: []v1.NotificationType{v1.NotificationType_NOTIFICATION_TYPE_REMINDERS}},
+		},
+		UpdatedAt:     now,
+		DigestWeekday: v1.Weekday_WEEKDAY_FRIDAY,
+	}
+	feed := &v1.CalendarFeed{UserId: "jo", Token: "token", CreatedAt: now}
+	appPassword := &v1.AppPassword{Id: "phone", UserId: "jo", Name: "Phone", PasswordHash: "hash", CreatedAt: now}
+
+	// a database from before migrations existed
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	_, err = db.Exec(blobSchema)
+	require.NoError(t, err)
+	insert := func(query string, message proto.Message, args ...any) {
+		data, err := proto.Marshal(message)
+		require.NoError(t, err)
+		_, err = db.Exec(query, append(args, data)...)
+		require.NoError(t, err)
+	}
+	insert("INSERT INTO users (id, data) VALUES (?, ?)", jo, jo.Id)
+	insert("INSERT INTO users (id, data) VALUES (?, ?)", sam, sam.Id)
+	insert("INSERT INTO clubs (id, data) VALUES (?, ?)", club, club.Id)
+	insert("INSERT INTO picks (id, club_id, data) VALUES (?, ?, ?)", heat, heat.Id, heat.ClubId)
+	orphan := &v1.Pick{Id: "orphan", ClubId: "deleted", UserId: "jo", Title: "Orphan"}
+	insert("INSERT INTO picks (id, club_id, data) VALUES (?, ?, ?)", orphan, orphan.Id, orphan.ClubId)
+	for _, assignment := range scheduled {
+		insert("INSERT INTO scheduled_picks (id, club_id, data) VALUES (?, ?, ?)", assignment, assignment.Id, assignment.ClubId)
+	}
+	insert("INSERT INTO notification_preferences (user_id, data) VALUES (?, ?)", prefs, prefs.UserId)
+	insert("INSERT INTO calendar_feeds (user_id, token, data) VALUES (?, ?, ?)", feed, feed.UserId, feed.Token)
+	insert("INSERT INTO app_passwords (id, user_id, data) VALUES (?, ?, ?)", appPassword, appPassword.Id, appPassword.UserId)
+	require.NoError(t, db.Close())
+
+	s := newTestSQLiteStorage(t, path)
+
+	var versions []int
+	rows, err := s.db.Query("SELECT version FROM schema_migrations ORDER BY version")
+	require.NoError(t, err)
+	for rows.Next() {
+		var version int
+		require.NoError(t, rows.Scan(&version))
+		versions = append(versions, version)
+	}
+	require.NoError(t, rows.Close())
+	assert.Equal(t, []int{1, 2, 3, 4}, versions)
+
+	gotUser, err := s.GetUserByEmail(ctx, "jo@example.com")
+	require.NoError(t, err)
+	assertProtoEqual(t, jo, gotUser)
+
+	// memberships of users that no longer exist are dropped
+	gotClub, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	club.MemberIds = []string{"sam", "jo"}
+	assertProtoEqual(t, club, gotClub)
+
+	picks, err := s.ListPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, picks, 2)
+	_, err = s.GetPick(ctx, "orphan")
+	assert.Error(t, err)
+
+	gotScheduled, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, gotScheduled, 2)
+	for i := range scheduled {
+		assertProtoEqual(t, scheduled[i], gotScheduled[i])
+	}
+
+	gotPrefs, err := s.GetNotificationPreferences(ctx, "jo")
+	require.NoError(t, err)
+	assertProtoEqual(t, prefs, gotPrefs)
+
+	gotFeed, err := s.GetCalendarFeedByToken(ctx, "token")
+	require.NoError(t, err)
+	assertProtoEqual(t, feed, gotFeed)
+
+	gotAppPasswords, err := s.ListAppPasswords(ctx, "jo")
+	require.NoError(t, err)
+	require.Len(t, gotAppPasswords, 1)
+	assertProtoEqual(t, appPassword, gotAppPasswords[0])
+
+	// opening it again doesn't migrate again
+	require.NoError(t, s.db.Close())
+	s = newTestSQLiteStorage(t, path)
+	gotClub, err = s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assertProtoEqual(t, club, gotClub)
+}
+
+func Test_SQLite_MigrateDuplicateEmails(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+
+	// the blob tables didn't stop users from sharing an email
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	_, err = db.Exec(blobSchema)
+	require.NoError(t, err)
+	putUser := func(user *v1.User) {
+		data, err := proto.Marshal(user)
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT OR REPLACE INTO users (id, data) VALUES (?, ?)", user.Id, data)
+		require.NoError(t, err)
+	}
+	putUser(&v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"})
+	putUser(&v1.User{Id: "jo2", Name: "Jo", Email: "jo@example.com"})
+	putUser(&v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"})
+	putUser(&v1.User{Id: "al", Name: "Al", Email: "sam@example.com"})
+	putUser(&v1.User{Id: "ed", Name: "Ed", Email: "ed@example.com"})
+	require.NoError(t, db.Close())
+
+	_, err = NewSQLiteStorage(path)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "jo@example.com (users jo, jo2); sam@example.com (users al, sam)")
+	assert.NotContains(t, err.Error(), "ed@example.com")
+
+	// nothing was migrated, so the users can be fixed and the migration tried again
+	db, err = sql.Open("sqlite", path)
+	require.NoError(t, err)
+	putUser(&v1.User{Id: "jo2", Name: "Jo", Email: "jo2@example.com"})
+	putUser(&v1.User{Id: "al", Name: "Al", Email: "al@example.com"})
+	require.NoError(t, db.Close())
+
+	s := newTestSQLiteStorage(t, path)
+	users, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 5)
+}
+
+func Test_SQLite_Constraints(t *testing.T) {
+	testSQLConstraints(t, newTestSQLiteStorage(t, filepath.Join(t.TempDir(), "watchclub.db")))
+}
+
+// testSQLConstraints checks the constraints of the SQL schema
+func testSQLConstraints(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	err := s.CreateUser(ctx, &v1.User{Id: "jo2", Name: "Jo", Email: "jo@example.com"})
+	assert.ErrorIs(t, err, ErrAlreadyExists, "emails are unique")
+	assert.Equal(t, "already exists", ClientMessage(err), "the database's error isn't shown to clients")
+
+	assert.Error(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo", "nobody"}}), "members must exist")
+	_, err = s.GetClub(ctx, "club")
+	assert.Error(t, err, "failed clubs aren't partially created")
+
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}))
+	assert.Error(t, s.CreatePick(ctx, &v1.Pick{Id: "heat", ClubId: "nowhere", UserId: "jo", Title: "Heat"}), "clubs must exist")
+
+	club, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	club.MemberIds = append(club.MemberIds, "sam")
+	club.Started = true
+	require.NoError(t, s.UpdateClub(ctx, club))
+
+	clubs, err := s.ListClubsForUser(ctx, "sam")
+	require.NoError(t, err)
+	require.Len(t, clubs, 1)
+	assert.Equal(t, []string{"jo", "sam"}, clubs[0].MemberIds)
+	assert.True(t, clubs[0].Started)
+
+	assert.Error(t, s.UpdateClub(ctx, &v1.Club{Id: "nowhere"}))
+
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "sam", Title: "Heat"}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	assignment := &v1.ScheduledPick{Id: "s1", ClubId: "club", SequenceNumber: 1, Pick: pick}
+	require.NoError(t, s.CreateScheduledPick(ctx, assignment))
+	assert.Error(t, s.DeletePick(ctx, "heat"), "scheduled picks must be deleted first")
+
+	assignment.CalendarSequence = 1
+	require.NoError(t, s.UpdateScheduledPick(ctx, assignment))
+	got, err := s.GetScheduledPick(ctx, "s1")
+	require.NoError(t, err)
+	assertProtoEqual(t, assignment, got)
+
+	// deleting a club deletes its memberships
+	require.NoError(t, s.DeleteScheduledPick(ctx, "s1"))
+	require.NoError(t, s.DeletePick(ctx, "heat"))
+	require.NoError(t, s.DeleteClub(ctx, "club"))
+	clubs, err = s.ListClubsForUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Empty(t, clubs)
+}
+
+func Test_SQLite_NewerSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+	s := newTestSQLiteStorage(t, path)
+	_, err := s.db.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (1000, 'future', 0)")
+	require.NoError(t, err)
+
+	_, err = NewSQLiteStorage(path)
+	assert.ErrorContains(t, err, "newer")
+}
+
+func Test_SQLite_OpenBackuper(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// a database that doesn't exist isn't created
+	missing := filepath.Join(dir, "missing.db")
+	_, err := OpenSQLiteBackuper(ctx, missing)
+	assert.Error(t, err)
+	_, err = os.Stat(missing)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// an older schema isn't migrated
+	path := filepath.Join(dir, "watchclub.db")
+	s := newTestSQLiteStorage(t, path)
+	_, err = s.db.Exec("DELETE FROM schema_migrations WHERE version > 1")
+	require.NoError(t, err)
+
+	b, err := OpenSQLiteBackuper(ctx, path)
+	require.NoError(t, err)
+	require.NoError(t, b.Backup(ctx, filepath.Join(dir, "backup.db")))
+	require.NoError(t, b.Close())
+	var versions int
+	require.NoError(t, s.db.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&versions))
+	assert.Equal(t, 1, versions)
+}
+
+func Test_SQLite_Options(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+	store, err := NewStorage("sqlite://" + path + "?synchronous=normal&busy_timeout=2s&max_open_conns=2&_time_format=sqlite")
+	require.NoError(t, err)
+	s := store.(*sqlStorage)
+	defer s.Close()
+
+	pragma := func(db *sql.DB, name string) string {
+		var value string
+		require.NoError(t, db.QueryRow("PRAGMA "+name).Scan(&value))
+		return value
+	}
+	for _, db := range []*sql.DB{s.db, s.reader} {
+		assert.Equal(t, "wal", pragma(db, "journal_mode"))
+		assert.Equal(t, "1", pragma(db, "synchronous"))
+		assert.Equal(t, "2000", pragma(db, "busy_timeout"))
+		assert.Equal(t, "1", pragma(db, "foreign_keys"))
+	}
+	assert.Equal(t, 1, s.db.Stats().MaxOpenConnections)
+	assert.Equal(t, 2, s.reader.Stats().MaxOpenConnections)
+
+	// reads see writes, but can't make them
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	user, err := s.GetUser(ctx, "jo")
+	require.NoError(t, err)
+	assert.Equal(t, "Jo", user.Name)
+	_, err = s.reader.Exec("DELETE FROM users")
+	assert.ErrorContains(t, err, "readonly")
+
+	// backups use a read connection, which is still read-only afterwards
+	require.NoError(t, s.Backup(ctx, filepath.Join(t.TempDir(), "backup.db")))
+	assert.Equal(t, "1", pragma(s.reader, "query_only"))
+}
+
+func Test_SQLite_InvalidOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+	for query, expected := range map[string]string{
+		"journal_mode=fast":  "invalid journal_mode: fast",
+		"synchronous=always": "invalid synchronous: always",
+		"busy_timeout=5":     "invalid busy_timeout: 5",
+		"busy_timeout=-1s":   "invalid busy_timeout: -1s",
+		"max_open_conns=0":   "invalid max_open_conns",
+		"max_open_conns=all": "invalid max_open_conns: all",
+	} {
+		_, err := NewStorage("sqlite://" + path + "?" + query)
+		assert.ErrorContains(t, err, expected, query)
+	}
+
+	// in-memory databases can't use WAL, which SQLite ignores rather than failing
+	_, err := NewSQLiteStorage(":memory:")
+	assert.ErrorContains(t, err, "failed to set journal_mode to WAL: database is using memory")
+	store, err := NewSQLiteStorage(":memory:?journal_mode=memory")
+	require.NoError(t, err)
+	store.(*sqlStorage).Close()
+}
+
+func newTestKeyring(t *testing.T, ids ...string) *keyring.Keyring {
+	t.Helper()
+	entries := []string{"index=" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{'i'}, 32))}
+	for _, id := range ids {
+		entries = append(entries, id+"="+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte(id[:1]), 32)))
+	}
+	keys, err := keyring.Parse(strings.Join(entries, ","))
+	require.NoError(t, err)
+	return keys
+}
+
+func Test_SQLite_EncryptedConformance(t *testing.T) {
+	RunConformanceTests(t, func(t *testing.T) Storage {
+		s := newTestSQLiteStorage(t, filepath.Join(t.TempDir(), "watchclub.db"))
+		require.NoError(t, UseKeyring(s, newTestKeyring(t, "k1")))
+		return s
+	})
+}
+
+func Test_SQLite_Encryption(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchclub.db")
+	s := newTestSQLiteStorage(t, path)
+	storedEmail := func(id string) string {
+		var email string
+		require.NoError(t, s.db.QueryRow("SELECT email FROM users WHERE id = ?", id).Scan(&email))
+		return email
+	}
+
+	// users created before encryption was turned on are still found, and rotating encrypts them
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	s.SetKeyring(newTestKeyring(t, "k1"))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "sam", Name: "Sam", Email: "sam@example.com"}))
+	assert.Equal(t, "jo@example.com", storedEmail("jo"))
+	assert.NotContains(t, storedEmail("sam"), "sam@example.com")
+	user, err := s.GetUserByEmail(ctx, "jo@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "jo", user.Id)
+
+	rotated, err := s.RotateKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rotated)
+	assert.NotContains(t, storedEmail("jo"), "jo@example.com")
+	assert.ErrorIs(t, s.CreateUser(ctx, &v1.User{Id: "jo2", Name: "Jo", Email: "jo@example.com"}), ErrAlreadyExists)
+
+	// a new key encrypts new records, the old one still decrypts, and rotating re-encrypts with the new one
+	s.SetKeyring(newTestKeyring(t, "k2", "k1"))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "al", Name: "Al", Email: "al@example.com"}))
+	assert.Contains(t, storedEmail("al"), ":k2:")
+	assert.Contains(t, storedEmail("jo"), ":k1:")
+	users, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	rotated, err = s.RotateKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	for _, id := range []string{"jo", "sam", "al"} {
+		assert.Contains(t, storedEmail(id), ":k2:")
+	}
+	rotated, err = s.RotateKeys(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, rotated)
+
+	// once the old key is dropped, everything is still readable
+	s.SetKeyring(newTestKeyring(t, "k2"))
+	user, err = s.GetUserByEmail(ctx, "sam@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "sam", user.Id)
+
+	// without keys, encrypted records can't be read
+	s.SetKeyring(nil)
+	_, err = s.GetUser(ctx, "sam")
+	assert.ErrorContains(t, err, "no keys were provided")
+
+	// an encrypted address can't be moved to another user
+	s.SetKeyring(newTestKeyring(t, "k2"))
+	samEmail := storedEmail("sam")
+	_, err = s.db.Exec("UPDATE users SET email = 'moved' WHERE id = 'sam'")
+	require.NoError(t, err)
+	_, err = s.db.Exec("UPDATE users SET email = ? WHERE id = 'al'", samEmail)
+	require.NoError(t, err)
+	_, err = s.GetUser(ctx, "al")
+	assert.ErrorContains(t, err, "failed to decrypt email of user al")
+}
+
+func Test_SQLite_CheckConsistency(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSQLiteStorage(t, filepath.Join(t.TempDir(), "watchclub.db"))
+	require.NoError(t, s.CreateUser(ctx, &v1.User{Id: "jo", Name: "Jo", Email: "jo@example.com"}))
+	require.NoError(t, s.CreateClub(ctx, &v1.Club{Id: "club", Name: "Movie Night", MemberIds: []string{"jo"}}))
+	pick := &v1.Pick{Id: "heat", ClubId: "club", UserId: "jo", Title: "Heat"}
+	require.NoError(t, s.CreatePick(ctx, pick))
+	require.NoError(t, s.CreateScheduledPick(ctx, &v1.ScheduledPick{Id: "s1", ClubId: "club", SequenceNumber: 1, Pick: pick}))
+
+	// orphans can only be left behind by databases from before the foreign keys
+	conn, err := s.db.Conn(ctx)
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF")
+	require.NoError(t, err)
+	for _, statement := range []string{
+		"INSERT INTO picks (id, club_id, user_id, title) VALUES ('alien', 'club', 'gone', 'Alien')",
+		"INSERT INTO scheduled_picks (id, club_id, pick_id, sequence_number) VALUES ('s2', 'club', 'alien', 2)",
+		"INSERT INTO club_members (club_id, user_id, position) VALUES ('club', 'gone', 1)",
+		"INSERT INTO notification_preferences (user_id) VALUES ('gone')",
+		"INSERT INTO disabled_notification_types (user_id, club_id, position, type) VALUES ('nobody', '', 0, 1)",
+		"INSERT INTO app_passwords (id, user_id, name, password_hash) VALUES ('pw', 'gone', 'Phone', 'hash')",
+	} {
+		_, err := conn.ExecContext(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+	_, err = conn.ExecContext(ctx, "PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	expected := []Orphan{
+		{Kind: "scheduled pick", ID: "s2", Reason: "its club or pick doesn't exist"},
+		{Kind: "pick", ID: "alien", Reason: "its club or user doesn't exist"},
+		{Kind: "club member", ID: "club/gone", Reason: "its club or user doesn't exist"},
+		{Kind: "disabled notification type", ID: "nobody//0", Reason: "its notification preferences don't exist"},
+		{Kind: "notification preferences", ID: "gone", Reason: "its user doesn't exist"},
+		{Kind: "app password", ID: "pw", Reason: "its user doesn't exist"},
+	}
+	orphans, err := s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, orphans)
+
+	orphans, err = s.CheckConsistency(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, expected, orphans)
+
+	orphans, err = s.CheckConsistency(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+
+	// the valid records are untouched
+	club, err := s.GetClub(ctx, "club")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jo"}, club.MemberIds)
+	scheduled, err := s.ListScheduledPicks(ctx, "club")
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+	assert.Equal(t, "s1", scheduled[0].Id)
+}
@@ -1,32 +1,82 @@
 package util
 
 import (
+	"context"
 	"sync"
 	"time"
 )
 
-type RateLimitedMutex interface {
-	Lock()
-	Unlock()
+// TokenBucket is a rate limiter that refills at a fixed rate and allows bursts up to its capacity
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
 }
 
-func NewRateLimitedMutex(delay time.Duration) RateLimitedMutex {
-	return &rateLimitedMutex{
-		m:     sync.Mutex{},
-		delay: delay,
+// NewTokenBucket creates a full bucket that refills at rate tokens per second, holding at most burst tokens
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
 	}
 }
 
-type rateLimitedMutex struct {
-	m     sync.Mutex
-	delay time.Duration
+// refill adds the tokens accrued since the last refill; the caller must hold the lock
+func (b *TokenBucket) refill(now time.Time) {
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
 }
 
-func (rlm *rateLimitedMutex) Lock() {
-	rlm.m.Lock()
+// Allow takes a token if one is available, without waiting
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
 }
 
-func (rlm *rateLimitedMutex) Unlock() {
-	time.Sleep(rlm.delay)
-	rlm.m.Unlock()
+// Wait takes a token, blocking until one is available or ctx is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// reserve a token now (possibly going into debt) so that concurrent waiters are served in order
+	b.mu.Lock()
+	b.refill(time.Now())
+	b.tokens--
+	delay := time.Duration(0)
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give back the reservation
+		b.mu.Lock()
+		b.tokens = min(b.burst, b.tokens+1)
+		b.mu.Unlock()
+		return ctx.Err()
+	}
 }
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -8,21 +9,39 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func Test_RateLimitMutex(t *testing.T) {
-	d := 100 * time.Millisecond
+func Test_TokenBucket_Wait(t *testing.T) {
+	rate := 10.0 // one token every 100ms
 	n := 10
-	m := NewRateLimitedMutex(d)
+	b := NewTokenBucket(rate, 1)
 
 	start := time.Now()
 
 	wg := sync.WaitGroup{}
 	for range n {
 		wg.Go(func() {
-			m.Lock()
-			defer m.Unlock()
+			assert.NoError(t, b.Wait(context.Background()))
 		})
 	}
 	wg.Wait()
 
-	assert.GreaterOrEqual(t, time.Since(start), d*time.Duration(n))
+	// the first token is available immediately
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond*time.Duration(n-1))
+}
+
+func Test_TokenBucket_Burst(t *testing.T) {
+	b := NewTokenBucket(1, 3)
+
+	assert.True(t, b.Allow())
+	assert.True(t, b.Allow())
+	assert.True(t, b.Allow())
+	assert.False(t, b.Allow())
+}
+
+func Test_TokenBucket_WaitCanceled(t *testing.T) {
+	b := NewTokenBucket(0.1, 1)
+	assert.True(t, b.Allow())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.Wait(ctx), context.DeadlineExceeded)
 }
@@ -809,6 +809,67 @@ proto.watchclub.WatchClubServicePromiseClient.prototype.listUserClubs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.ListPicksRequest,
+ *   !proto.watchclub.ListPicksResponse>}
+ */
+const methodDescriptor_WatchClubService_ListPicks = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/ListPicks',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.ListPicksRequest,
+  proto.watchclub.ListPicksResponse,
+  /**
+   * @param {!proto.watchclub.ListPicksRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.ListPicksResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.ListPicksRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.ListPicksResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.ListPicksResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.listPicks =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListPicks',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListPicks,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.ListPicksRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.ListPicksResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.listPicks =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListPicks',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListPicks);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -870,5 +931,859 @@ proto.watchclub.WatchClubServicePromiseClient.prototype.deleteClub =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.ListDeletedClubsRequest,
+ *   !proto.watchclub.ListDeletedClubsResponse>}
+ */
+const methodDescriptor_WatchClubService_ListDeletedClubs = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/ListDeletedClubs',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.ListDeletedClubsRequest,
+  proto.watchclub.ListDeletedClubsResponse,
+  /**
+   * @param {!proto.watchclub.ListDeletedClubsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.ListDeletedClubsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.ListDeletedClubsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.ListDeletedClubsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.ListDeletedClubsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.listDeletedClubs =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListDeletedClubs',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListDeletedClubs,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.ListDeletedClubsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.ListDeletedClubsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.listDeletedClubs =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListDeletedClubs',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListDeletedClubs);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.RestoreClubRequest,
+ *   !proto.watchclub.RestoreClubResponse>}
+ */
+const methodDescriptor_WatchClubService_RestoreClub = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/RestoreClub',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.RestoreClubRequest,
+  proto.watchclub.RestoreClubResponse,
+  /**
+   * @param {!proto.watchclub.RestoreClubRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.RestoreClubResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.RestoreClubRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.RestoreClubResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.RestoreClubResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.restoreClub =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/RestoreClub',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RestoreClub,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.RestoreClubRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.RestoreClubResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.restoreClub =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/RestoreClub',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RestoreClub);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.ListDeletedPicksRequest,
+ *   !proto.watchclub.ListDeletedPicksResponse>}
+ */
+const methodDescriptor_WatchClubService_ListDeletedPicks = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/ListDeletedPicks',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.ListDeletedPicksRequest,
+  proto.watchclub.ListDeletedPicksResponse,
+  /**
+   * @param {!proto.watchclub.ListDeletedPicksRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.ListDeletedPicksResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.ListDeletedPicksRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.ListDeletedPicksResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.ListDeletedPicksResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.listDeletedPicks =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListDeletedPicks',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListDeletedPicks,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.ListDeletedPicksRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.ListDeletedPicksResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.listDeletedPicks =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListDeletedPicks',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListDeletedPicks);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.RestorePickRequest,
+ *   !proto.watchclub.RestorePickResponse>}
+ */
+const methodDescriptor_WatchClubService_RestorePick = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/RestorePick',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.RestorePickRequest,
+  proto.watchclub.RestorePickResponse,
+  /**
+   * @param {!proto.watchclub.RestorePickRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.RestorePickResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.RestorePickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.RestorePickResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.RestorePickResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.restorePick =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/RestorePick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RestorePick,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.RestorePickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.RestorePickResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.restorePick =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/RestorePick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RestorePick);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.MoveScheduledPickRequest,
+ *   !proto.watchclub.MoveScheduledPickResponse>}
+ */
+const methodDescriptor_WatchClubService_MoveScheduledPick = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/MoveScheduledPick',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.MoveScheduledPickRequest,
+  proto.watchclub.MoveScheduledPickResponse,
+  /**
+   * @param {!proto.watchclub.MoveScheduledPickRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.MoveScheduledPickResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.MoveScheduledPickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.MoveScheduledPickResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.MoveScheduledPickResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.moveScheduledPick =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/MoveScheduledPick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_MoveScheduledPick,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.MoveScheduledPickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.MoveScheduledPickResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.moveScheduledPick =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/MoveScheduledPick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_MoveScheduledPick);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.DeleteScheduledPickRequest,
+ *   !proto.watchclub.DeleteScheduledPickResponse>}
+ */
+const methodDescriptor_WatchClubService_DeleteScheduledPick = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/DeleteScheduledPick',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.DeleteScheduledPickRequest,
+  proto.watchclub.DeleteScheduledPickResponse,
+  /**
+   * @param {!proto.watchclub.DeleteScheduledPickRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.DeleteScheduledPickResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.DeleteScheduledPickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.DeleteScheduledPickResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.DeleteScheduledPickResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.deleteScheduledPick =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/DeleteScheduledPick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_DeleteScheduledPick,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.DeleteScheduledPickRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.DeleteScheduledPickResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.deleteScheduledPick =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/DeleteScheduledPick',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_DeleteScheduledPick);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.ResetClubRequest,
+ *   !proto.watchclub.ResetClubResponse>}
+ */
+const methodDescriptor_WatchClubService_ResetClub = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/ResetClub',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.ResetClubRequest,
+  proto.watchclub.ResetClubResponse,
+  /**
+   * @param {!proto.watchclub.ResetClubRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.ResetClubResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.ResetClubRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.ResetClubResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.ResetClubResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.resetClub =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/ResetClub',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ResetClub,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.ResetClubRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.ResetClubResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.resetClub =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/ResetClub',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ResetClub);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.GetNotificationPreferencesRequest,
+ *   !proto.watchclub.GetNotificationPreferencesResponse>}
+ */
+const methodDescriptor_WatchClubService_GetNotificationPreferences = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/GetNotificationPreferences',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.GetNotificationPreferencesRequest,
+  proto.watchclub.GetNotificationPreferencesResponse,
+  /**
+   * @param {!proto.watchclub.GetNotificationPreferencesRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.GetNotificationPreferencesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.GetNotificationPreferencesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.GetNotificationPreferencesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.GetNotificationPreferencesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.getNotificationPreferences =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/GetNotificationPreferences',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_GetNotificationPreferences,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.GetNotificationPreferencesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.GetNotificationPreferencesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.getNotificationPreferences =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/GetNotificationPreferences',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_GetNotificationPreferences);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.UpdateNotificationPreferencesRequest,
+ *   !proto.watchclub.UpdateNotificationPreferencesResponse>}
+ */
+const methodDescriptor_WatchClubService_UpdateNotificationPreferences = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/UpdateNotificationPreferences',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.UpdateNotificationPreferencesRequest,
+  proto.watchclub.UpdateNotificationPreferencesResponse,
+  /**
+   * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.UpdateNotificationPreferencesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.UpdateNotificationPreferencesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.UpdateNotificationPreferencesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.updateNotificationPreferences =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/UpdateNotificationPreferences',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_UpdateNotificationPreferences,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.UpdateNotificationPreferencesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.updateNotificationPreferences =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/UpdateNotificationPreferences',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_UpdateNotificationPreferences);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.GetCalendarFeedRequest,
+ *   !proto.watchclub.GetCalendarFeedResponse>}
+ */
+const methodDescriptor_WatchClubService_GetCalendarFeed = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/GetCalendarFeed',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.GetCalendarFeedRequest,
+  proto.watchclub.GetCalendarFeedResponse,
+  /**
+   * @param {!proto.watchclub.GetCalendarFeedRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.GetCalendarFeedResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.GetCalendarFeedRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.GetCalendarFeedResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.GetCalendarFeedResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.getCalendarFeed =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/GetCalendarFeed',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_GetCalendarFeed,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.GetCalendarFeedRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.GetCalendarFeedResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.getCalendarFeed =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/GetCalendarFeed',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_GetCalendarFeed);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.RotateCalendarFeedTokenRequest,
+ *   !proto.watchclub.RotateCalendarFeedTokenResponse>}
+ */
+const methodDescriptor_WatchClubService_RotateCalendarFeedToken = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/RotateCalendarFeedToken',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.RotateCalendarFeedTokenRequest,
+  proto.watchclub.RotateCalendarFeedTokenResponse,
+  /**
+   * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.RotateCalendarFeedTokenResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.RotateCalendarFeedTokenResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.RotateCalendarFeedTokenResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.rotateCalendarFeedToken =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/RotateCalendarFeedToken',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RotateCalendarFeedToken,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.RotateCalendarFeedTokenResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.rotateCalendarFeedToken =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/RotateCalendarFeedToken',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_RotateCalendarFeedToken);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.CreateAppPasswordRequest,
+ *   !proto.watchclub.CreateAppPasswordResponse>}
+ */
+const methodDescriptor_WatchClubService_CreateAppPassword = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/CreateAppPassword',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.CreateAppPasswordRequest,
+  proto.watchclub.CreateAppPasswordResponse,
+  /**
+   * @param {!proto.watchclub.CreateAppPasswordRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.CreateAppPasswordResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.CreateAppPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.CreateAppPasswordResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.CreateAppPasswordResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.createAppPassword =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/CreateAppPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_CreateAppPassword,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.CreateAppPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.CreateAppPasswordResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.createAppPassword =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/CreateAppPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_CreateAppPassword);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.ListAppPasswordsRequest,
+ *   !proto.watchclub.ListAppPasswordsResponse>}
+ */
+const methodDescriptor_WatchClubService_ListAppPasswords = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/ListAppPasswords',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.ListAppPasswordsRequest,
+  proto.watchclub.ListAppPasswordsResponse,
+  /**
+   * @param {!proto.watchclub.ListAppPasswordsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.ListAppPasswordsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.ListAppPasswordsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.ListAppPasswordsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.ListAppPasswordsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.listAppPasswords =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListAppPasswords',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListAppPasswords,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.ListAppPasswordsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.ListAppPasswordsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.listAppPasswords =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/ListAppPasswords',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_ListAppPasswords);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.watchclub.DeleteAppPasswordRequest,
+ *   !proto.watchclub.DeleteAppPasswordResponse>}
+ */
+const methodDescriptor_WatchClubService_DeleteAppPassword = new grpc.web.MethodDescriptor(
+  '/watchclub.WatchClubService/DeleteAppPassword',
+  grpc.web.MethodType.UNARY,
+  proto.watchclub.DeleteAppPasswordRequest,
+  proto.watchclub.DeleteAppPasswordResponse,
+  /**
+   * @param {!proto.watchclub.DeleteAppPasswordRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.watchclub.DeleteAppPasswordResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.watchclub.DeleteAppPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.watchclub.DeleteAppPasswordResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.watchclub.DeleteAppPasswordResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.watchclub.WatchClubServiceClient.prototype.deleteAppPassword =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/watchclub.WatchClubService/DeleteAppPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_DeleteAppPassword,
+      callback);
+};
+
+
+/**
+ * @param {!proto.watchclub.DeleteAppPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.watchclub.DeleteAppPasswordResponse>}
+ *     Promise that resolves to the response
+ */
+proto.watchclub.WatchClubServicePromiseClient.prototype.deleteAppPassword =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/watchclub.WatchClubService/DeleteAppPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WatchClubService_DeleteAppPassword);
+};
+
+
 module.exports = proto.watchclub;
 
@@ -20,35 +20,71 @@ var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/time
 goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.watchclub.AddPickRequest', null, global);
 goog.exportSymbol('proto.watchclub.AddPickResponse', null, global);
+goog.exportSymbol('proto.watchclub.AppPassword', null, global);
+goog.exportSymbol('proto.watchclub.CalendarFeed', null, global);
 goog.exportSymbol('proto.watchclub.Club', null, global);
+goog.exportSymbol('proto.watchclub.ClubNotificationPreferences', null, global);
+goog.exportSymbol('proto.watchclub.CreateAppPasswordRequest', null, global);
+goog.exportSymbol('proto.watchclub.CreateAppPasswordResponse', null, global);
 goog.exportSymbol('proto.watchclub.CreateClubRequest', null, global);
 goog.exportSymbol('proto.watchclub.CreateClubResponse', null, global);
 goog.exportSymbol('proto.watchclub.CreateUserRequest', null, global);
 goog.exportSymbol('proto.watchclub.CreateUserResponse', null, global);
+goog.exportSymbol('proto.watchclub.DeleteAppPasswordRequest', null, global);
+goog.exportSymbol('proto.watchclub.DeleteAppPasswordResponse', null, global);
 goog.exportSymbol('proto.watchclub.DeleteClubRequest', null, global);
 goog.exportSymbol('proto.watchclub.DeleteClubResponse', null, global);
 goog.exportSymbol('proto.watchclub.DeletePickRequest', null, global);
 goog.exportSymbol('proto.watchclub.DeletePickResponse', null, global);
+goog.exportSymbol('proto.watchclub.DeleteScheduledPickRequest', null, global);
+goog.exportSymbol('proto.watchclub.DeleteScheduledPickResponse', null, global);
+goog.exportSymbol('proto.watchclub.GetCalendarFeedRequest', null, global);
+goog.exportSymbol('proto.watchclub.GetCalendarFeedResponse', null, global);
 goog.exportSymbol('proto.watchclub.GetClubCalendarRequest', null, global);
 goog.exportSymbol('proto.watchclub.GetClubCalendarResponse', null, global);
 goog.exportSymbol('proto.watchclub.GetClubRequest', null, global);
 goog.exportSymbol('proto.watchclub.GetClubResponse', null, global);
+goog.exportSymbol('proto.watchclub.GetNotificationPreferencesRequest', null, global);
+goog.exportSymbol('proto.watchclub.GetNotificationPreferencesResponse', null, global);
 goog.exportSymbol('proto.watchclub.GetScheduledPicksRequest', null, global);
 goog.exportSymbol('proto.watchclub.GetScheduledPicksResponse', null, global);
 goog.exportSymbol('proto.watchclub.GetUserRequest', null, global);
 goog.exportSymbol('proto.watchclub.GetUserResponse', null, global);
 goog.exportSymbol('proto.watchclub.JoinClubRequest', null, global);
 goog.exportSymbol('proto.watchclub.JoinClubResponse', null, global);
+goog.exportSymbol('proto.watchclub.ListAppPasswordsRequest', null, global);
+goog.exportSymbol('proto.watchclub.ListAppPasswordsResponse', null, global);
+goog.exportSymbol('proto.watchclub.ListDeletedClubsRequest', null, global);
+goog.exportSymbol('proto.watchclub.ListDeletedClubsResponse', null, global);
+goog.exportSymbol('proto.watchclub.ListDeletedPicksRequest', null, global);
+goog.exportSymbol('proto.watchclub.ListDeletedPicksResponse', null, global);
+goog.exportSymbol('proto.watchclub.ListPicksRequest', null, global);
+goog.exportSymbol('proto.watchclub.ListPicksResponse', null, global);
 goog.exportSymbol('proto.watchclub.ListUserClubsRequest', null, global);
 goog.exportSymbol('proto.watchclub.ListUserClubsResponse', null, global);
+goog.exportSymbol('proto.watchclub.MoveScheduledPickRequest', null, global);
+goog.exportSymbol('proto.watchclub.MoveScheduledPickResponse', null, global);
+goog.exportSymbol('proto.watchclub.NotificationPreferences', null, global);
+goog.exportSymbol('proto.watchclub.NotificationType', null, global);
 goog.exportSymbol('proto.watchclub.Pick', null, global);
+goog.exportSymbol('proto.watchclub.ResetClubRequest', null, global);
+goog.exportSymbol('proto.watchclub.ResetClubResponse', null, global);
+goog.exportSymbol('proto.watchclub.RestoreClubRequest', null, global);
+goog.exportSymbol('proto.watchclub.RestoreClubResponse', null, global);
+goog.exportSymbol('proto.watchclub.RestorePickRequest', null, global);
+goog.exportSymbol('proto.watchclub.RestorePickResponse', null, global);
+goog.exportSymbol('proto.watchclub.RotateCalendarFeedTokenRequest', null, global);
+goog.exportSymbol('proto.watchclub.RotateCalendarFeedTokenResponse', null, global);
 goog.exportSymbol('proto.watchclub.ScheduleIntervalUnit', null, global);
 goog.exportSymbol('proto.watchclub.ScheduledPick', null, global);
 goog.exportSymbol('proto.watchclub.SendLoginEmailRequest', null, global);
 goog.exportSymbol('proto.watchclub.SendLoginEmailResponse', null, global);
 goog.exportSymbol('proto.watchclub.StartClubRequest', null, global);
 goog.exportSymbol('proto.watchclub.StartClubResponse', null, global);
+goog.exportSymbol('proto.watchclub.UpdateNotificationPreferencesRequest', null, global);
+goog.exportSymbol('proto.watchclub.UpdateNotificationPreferencesResponse', null, global);
 goog.exportSymbol('proto.watchclub.User', null, global);
+goog.exportSymbol('proto.watchclub.Weekday', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -133,6 +169,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.watchclub.ScheduledPick.displayName = 'proto.watchclub.ScheduledPick';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.NotificationPreferences = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.NotificationPreferences.repeatedFields_, null);
+};
+goog.inherits(proto.watchclub.NotificationPreferences, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.NotificationPreferences.displayName = 'proto.watchclub.NotificationPreferences';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ClubNotificationPreferences = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.ClubNotificationPreferences.repeatedFields_, null);
+};
+goog.inherits(proto.watchclub.ClubNotificationPreferences, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ClubNotificationPreferences.displayName = 'proto.watchclub.ClubNotificationPreferences';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -637,6 +715,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.watchclub.ListUserClubsResponse.displayName = 'proto.watchclub.ListUserClubsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ListPicksRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.ListPicksRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListPicksRequest.displayName = 'proto.watchclub.ListPicksRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ListPicksResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.ListPicksResponse.repeatedFields_, null);
+};
+goog.inherits(proto.watchclub.ListPicksResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListPicksResponse.displayName = 'proto.watchclub.ListPicksResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -679,143 +799,785 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.watchclub.DeleteClubResponse.displayName = 'proto.watchclub.DeleteClubResponse';
 }
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.watchclub.Club.repeatedFields_ = [3];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.watchclub.Club.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.Club.toObject(opt_includeInstance, this);
+proto.watchclub.ListDeletedClubsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.watchclub.ListDeletedClubsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListDeletedClubsRequest.displayName = 'proto.watchclub.ListDeletedClubsRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.watchclub.Club} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.watchclub.Club.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    memberIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    started: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    maxPicksPerMember: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    scheduleIntervalQuantity: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    scheduleIntervalUnit: jspb.Message.getFieldWithDefault(msg, 9, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.watchclub.ListDeletedClubsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.ListDeletedClubsResponse.repeatedFields_, null);
 };
+goog.inherits(proto.watchclub.ListDeletedClubsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListDeletedClubsResponse.displayName = 'proto.watchclub.ListDeletedClubsResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.Club}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.watchclub.Club.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.Club;
-  return proto.watchclub.Club.deserializeBinaryFromReader(msg, reader);
+proto.watchclub.RestoreClubRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.watchclub.RestoreClubRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RestoreClubRequest.displayName = 'proto.watchclub.RestoreClubRequest';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.watchclub.Club} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.Club}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.watchclub.Club.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addMemberIds(value);
-      break;
-    case 4:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStartDate(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setStarted(value);
-      break;
-    case 6:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreatedAt(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxPicksPerMember(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setScheduleIntervalQuantity(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (reader.readEnum());
-      msg.setScheduleIntervalUnit(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.watchclub.RestoreClubResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.watchclub.RestoreClubResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RestoreClubResponse.displayName = 'proto.watchclub.RestoreClubResponse';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.watchclub.Club.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
+proto.watchclub.ListDeletedPicksRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.ListDeletedPicksRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListDeletedPicksRequest.displayName = 'proto.watchclub.ListDeletedPicksRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ListDeletedPicksResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.ListDeletedPicksResponse.repeatedFields_, null);
+};
+goog.inherits(proto.watchclub.ListDeletedPicksResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListDeletedPicksResponse.displayName = 'proto.watchclub.ListDeletedPicksResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.RestorePickRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.RestorePickRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RestorePickRequest.displayName = 'proto.watchclub.RestorePickRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.RestorePickResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.RestorePickResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RestorePickResponse.displayName = 'proto.watchclub.RestorePickResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.MoveScheduledPickRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.MoveScheduledPickRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.MoveScheduledPickRequest.displayName = 'proto.watchclub.MoveScheduledPickRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.MoveScheduledPickResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.MoveScheduledPickResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.MoveScheduledPickResponse.displayName = 'proto.watchclub.MoveScheduledPickResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.DeleteScheduledPickRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.DeleteScheduledPickRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.DeleteScheduledPickRequest.displayName = 'proto.watchclub.DeleteScheduledPickRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.DeleteScheduledPickResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.DeleteScheduledPickResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.DeleteScheduledPickResponse.displayName = 'proto.watchclub.DeleteScheduledPickResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ResetClubRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.ResetClubRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ResetClubRequest.displayName = 'proto.watchclub.ResetClubRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ResetClubResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.ResetClubResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ResetClubResponse.displayName = 'proto.watchclub.ResetClubResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.GetNotificationPreferencesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.GetNotificationPreferencesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.GetNotificationPreferencesRequest.displayName = 'proto.watchclub.GetNotificationPreferencesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.GetNotificationPreferencesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.GetNotificationPreferencesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.GetNotificationPreferencesResponse.displayName = 'proto.watchclub.GetNotificationPreferencesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.UpdateNotificationPreferencesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.UpdateNotificationPreferencesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.UpdateNotificationPreferencesRequest.displayName = 'proto.watchclub.UpdateNotificationPreferencesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.UpdateNotificationPreferencesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.UpdateNotificationPreferencesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.UpdateNotificationPreferencesResponse.displayName = 'proto.watchclub.UpdateNotificationPreferencesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.CalendarFeed = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.CalendarFeed, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.CalendarFeed.displayName = 'proto.watchclub.CalendarFeed';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.GetCalendarFeedRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.GetCalendarFeedRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.GetCalendarFeedRequest.displayName = 'proto.watchclub.GetCalendarFeedRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.GetCalendarFeedResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.GetCalendarFeedResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.GetCalendarFeedResponse.displayName = 'proto.watchclub.GetCalendarFeedResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.RotateCalendarFeedTokenRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.RotateCalendarFeedTokenRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RotateCalendarFeedTokenRequest.displayName = 'proto.watchclub.RotateCalendarFeedTokenRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.RotateCalendarFeedTokenResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.RotateCalendarFeedTokenResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.RotateCalendarFeedTokenResponse.displayName = 'proto.watchclub.RotateCalendarFeedTokenResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.AppPassword = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.AppPassword, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.AppPassword.displayName = 'proto.watchclub.AppPassword';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.CreateAppPasswordRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.CreateAppPasswordRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.CreateAppPasswordRequest.displayName = 'proto.watchclub.CreateAppPasswordRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.CreateAppPasswordResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.CreateAppPasswordResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.CreateAppPasswordResponse.displayName = 'proto.watchclub.CreateAppPasswordResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ListAppPasswordsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.ListAppPasswordsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListAppPasswordsRequest.displayName = 'proto.watchclub.ListAppPasswordsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.ListAppPasswordsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.watchclub.ListAppPasswordsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.watchclub.ListAppPasswordsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.ListAppPasswordsResponse.displayName = 'proto.watchclub.ListAppPasswordsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.DeleteAppPasswordRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.DeleteAppPasswordRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.DeleteAppPasswordRequest.displayName = 'proto.watchclub.DeleteAppPasswordRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.watchclub.DeleteAppPasswordResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.watchclub.DeleteAppPasswordResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.watchclub.DeleteAppPasswordResponse.displayName = 'proto.watchclub.DeleteAppPasswordResponse';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.Club.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.Club.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.Club.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.Club} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.Club.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    memberIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    started: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    maxPicksPerMember: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    scheduleIntervalQuantity: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    scheduleIntervalUnit: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    updatedAt: (f = msg.getUpdatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    deletedAt: (f = msg.getDeletedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.Club}
+ */
+proto.watchclub.Club.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.Club;
+  return proto.watchclub.Club.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.Club} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.Club}
+ */
+proto.watchclub.Club.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMemberIds(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartDate(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStarted(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxPicksPerMember(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScheduleIntervalQuantity(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (reader.readEnum());
+      msg.setScheduleIntervalUnit(value);
+      break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUpdatedAt(value);
+      break;
+    case 11:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeletedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.Club.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
   proto.watchclub.Club.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
@@ -824,299 +1586,6411 @@ proto.watchclub.Club.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.Club} message
+ * @param {!proto.watchclub.Club} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.Club.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMemberIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getStartDate();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getStarted();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxPicksPerMember();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getScheduleIntervalQuantity();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+  f = message.getScheduleIntervalUnit();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getDeletedAt();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.watchclub.Club.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.watchclub.Club.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string member_ids = 3;
+ * @return {!Array<string>}
+ */
+proto.watchclub.Club.prototype.getMemberIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setMemberIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.addMemberIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.clearMemberIdsList = function() {
+  return this.setMemberIdsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start_date = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Club.prototype.getStartDate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Club} returns this
+*/
+proto.watchclub.Club.prototype.setStartDate = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.clearStartDate = function() {
+  return this.setStartDate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Club.prototype.hasStartDate = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool started = 5;
+ * @return {boolean}
+ */
+proto.watchclub.Club.prototype.getStarted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setStarted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Club.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Club} returns this
+*/
+proto.watchclub.Club.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Club.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional int32 max_picks_per_member = 7;
+ * @return {number}
+ */
+proto.watchclub.Club.prototype.getMaxPicksPerMember = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setMaxPicksPerMember = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 schedule_interval_quantity = 8;
+ * @return {number}
+ */
+proto.watchclub.Club.prototype.getScheduleIntervalQuantity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setScheduleIntervalQuantity = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional ScheduleIntervalUnit schedule_interval_unit = 9;
+ * @return {!proto.watchclub.ScheduleIntervalUnit}
+ */
+proto.watchclub.Club.prototype.getScheduleIntervalUnit = function() {
+  return /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.watchclub.ScheduleIntervalUnit} value
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.setScheduleIntervalUnit = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp updated_at = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Club.prototype.getUpdatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Club} returns this
+*/
+proto.watchclub.Club.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.clearUpdatedAt = function() {
+  return this.setUpdatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Club.prototype.hasUpdatedAt = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp deleted_at = 11;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Club.prototype.getDeletedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 11));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Club} returns this
+*/
+proto.watchclub.Club.prototype.setDeletedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Club} returns this
+ */
+proto.watchclub.Club.prototype.clearDeletedAt = function() {
+  return this.setDeletedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Club.prototype.hasDeletedAt = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.User.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.User.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.User} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.User.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.User}
+ */
+proto.watchclub.User.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.User;
+  return proto.watchclub.User.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.User} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.User}
+ */
+proto.watchclub.User.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.User.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.User.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.User} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.User.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.watchclub.User.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.User} returns this
+ */
+proto.watchclub.User.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.watchclub.User.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.User} returns this
+ */
+proto.watchclub.User.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string email = 3;
+ * @return {string}
+ */
+proto.watchclub.User.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.User} returns this
+ */
+proto.watchclub.User.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.User.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.User} returns this
+*/
+proto.watchclub.User.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.User} returns this
+ */
+proto.watchclub.User.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.User.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.Pick.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.Pick.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.Pick} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.Pick.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    clubId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    title: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    notes: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    link: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    deletedAt: (f = msg.getDeletedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.Pick}
+ */
+proto.watchclub.Pick.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.Pick;
+  return proto.watchclub.Pick.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.Pick} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.Pick}
+ */
+proto.watchclub.Pick.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTitle(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setYear(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNotes(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLink(value);
+      break;
+    case 9:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeletedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.Pick.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.Pick.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.Pick} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.Pick.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTitle();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getNotes();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLink();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getDeletedAt();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string club_id = 2;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string user_id = 3;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string title = 4;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setTitle = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int32 year = 5;
+ * @return {number}
+ */
+proto.watchclub.Pick.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string notes = 6;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getNotes = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setNotes = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Pick.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Pick} returns this
+*/
+proto.watchclub.Pick.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Pick.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional string link = 8;
+ * @return {string}
+ */
+proto.watchclub.Pick.prototype.getLink = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.setLink = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp deleted_at = 9;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.Pick.prototype.getDeletedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.Pick} returns this
+*/
+proto.watchclub.Pick.prototype.setDeletedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.Pick} returns this
+ */
+proto.watchclub.Pick.prototype.clearDeletedAt = function() {
+  return this.setDeletedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.Pick.prototype.hasDeletedAt = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ScheduledPick.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ScheduledPick.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ScheduledPick} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ScheduledPick.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    clubId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sequenceNumber: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    pick: (f = msg.getPick()) && proto.watchclub.Pick.toObject(includeInstance, f),
+    calendarUid: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    calendarSequence: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ScheduledPick}
+ */
+proto.watchclub.ScheduledPick.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ScheduledPick;
+  return proto.watchclub.ScheduledPick.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ScheduledPick} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ScheduledPick}
+ */
+proto.watchclub.ScheduledPick.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSequenceNumber(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartDate(value);
+      break;
+    case 5:
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.setPick(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCalendarUid(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCalendarSequence(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.ScheduledPick.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ScheduledPick.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ScheduledPick} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ScheduledPick.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSequenceNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getStartDate();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getPick();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.watchclub.Pick.serializeBinaryToWriter
+    );
+  }
+  f = message.getCalendarUid();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCalendarSequence();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.watchclub.ScheduledPick.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string club_id = 2;
+ * @return {string}
+ */
+proto.watchclub.ScheduledPick.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 sequence_number = 3;
+ * @return {number}
+ */
+proto.watchclub.ScheduledPick.prototype.getSequenceNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.setSequenceNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start_date = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.ScheduledPick.prototype.getStartDate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+*/
+proto.watchclub.ScheduledPick.prototype.setStartDate = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.clearStartDate = function() {
+  return this.setStartDate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.ScheduledPick.prototype.hasStartDate = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional Pick pick = 5;
+ * @return {?proto.watchclub.Pick}
+ */
+proto.watchclub.ScheduledPick.prototype.getPick = function() {
+  return /** @type{?proto.watchclub.Pick} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Pick, 5));
+};
+
+
+/**
+ * @param {?proto.watchclub.Pick|undefined} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+*/
+proto.watchclub.ScheduledPick.prototype.setPick = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.clearPick = function() {
+  return this.setPick(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.ScheduledPick.prototype.hasPick = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string calendar_uid = 6;
+ * @return {string}
+ */
+proto.watchclub.ScheduledPick.prototype.getCalendarUid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.setCalendarUid = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional int32 calendar_sequence = 7;
+ * @return {number}
+ */
+proto.watchclub.ScheduledPick.prototype.getCalendarSequence = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.ScheduledPick} returns this
+ */
+proto.watchclub.ScheduledPick.prototype.setCalendarSequence = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.NotificationPreferences.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.NotificationPreferences.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.NotificationPreferences.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.NotificationPreferences} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.NotificationPreferences.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    unsubscribedAll: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    disabledTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    clubsList: jspb.Message.toObjectList(msg.getClubsList(),
+    proto.watchclub.ClubNotificationPreferences.toObject, includeInstance),
+    updatedAt: (f = msg.getUpdatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    digestWeekday: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    lastDigestSentAt: (f = msg.getLastDigestSentAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.NotificationPreferences}
+ */
+proto.watchclub.NotificationPreferences.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.NotificationPreferences;
+  return proto.watchclub.NotificationPreferences.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.NotificationPreferences} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.NotificationPreferences}
+ */
+proto.watchclub.NotificationPreferences.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUnsubscribedAll(value);
+      break;
+    case 3:
+      var values = /** @type {!Array<!proto.watchclub.NotificationType>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addDisabledTypes(values[i]);
+      }
+      break;
+    case 4:
+      var value = new proto.watchclub.ClubNotificationPreferences;
+      reader.readMessage(value,proto.watchclub.ClubNotificationPreferences.deserializeBinaryFromReader);
+      msg.addClubs(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUpdatedAt(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.watchclub.Weekday} */ (reader.readEnum());
+      msg.setDigestWeekday(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastDigestSentAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.NotificationPreferences.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.NotificationPreferences.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.NotificationPreferences} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.NotificationPreferences.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUnsubscribedAll();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getDisabledTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getClubsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.watchclub.ClubNotificationPreferences.serializeBinaryToWriter
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getDigestWeekday();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getLastDigestSentAt();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string user_id = 1;
+ * @return {string}
+ */
+proto.watchclub.NotificationPreferences.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool unsubscribed_all = 2;
+ * @return {boolean}
+ */
+proto.watchclub.NotificationPreferences.prototype.getUnsubscribedAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.setUnsubscribedAll = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * repeated NotificationType disabled_types = 3;
+ * @return {!Array<!proto.watchclub.NotificationType>}
+ */
+proto.watchclub.NotificationPreferences.prototype.getDisabledTypesList = function() {
+  return /** @type {!Array<!proto.watchclub.NotificationType>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.NotificationType>} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.setDisabledTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.watchclub.NotificationType} value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.addDisabledTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.clearDisabledTypesList = function() {
+  return this.setDisabledTypesList([]);
+};
+
+
+/**
+ * repeated ClubNotificationPreferences clubs = 4;
+ * @return {!Array<!proto.watchclub.ClubNotificationPreferences>}
+ */
+proto.watchclub.NotificationPreferences.prototype.getClubsList = function() {
+  return /** @type{!Array<!proto.watchclub.ClubNotificationPreferences>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.ClubNotificationPreferences, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.ClubNotificationPreferences>} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+*/
+proto.watchclub.NotificationPreferences.prototype.setClubsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.ClubNotificationPreferences=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.ClubNotificationPreferences}
+ */
+proto.watchclub.NotificationPreferences.prototype.addClubs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.watchclub.ClubNotificationPreferences, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.clearClubsList = function() {
+  return this.setClubsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp updated_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.NotificationPreferences.prototype.getUpdatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+*/
+proto.watchclub.NotificationPreferences.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.clearUpdatedAt = function() {
+  return this.setUpdatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.NotificationPreferences.prototype.hasUpdatedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional Weekday digest_weekday = 6;
+ * @return {!proto.watchclub.Weekday}
+ */
+proto.watchclub.NotificationPreferences.prototype.getDigestWeekday = function() {
+  return /** @type {!proto.watchclub.Weekday} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.watchclub.Weekday} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.setDigestWeekday = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_digest_sent_at = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.NotificationPreferences.prototype.getLastDigestSentAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+*/
+proto.watchclub.NotificationPreferences.prototype.setLastDigestSentAt = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.NotificationPreferences} returns this
+ */
+proto.watchclub.NotificationPreferences.prototype.clearLastDigestSentAt = function() {
+  return this.setLastDigestSentAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.NotificationPreferences.prototype.hasLastDigestSentAt = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.ClubNotificationPreferences.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ClubNotificationPreferences.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ClubNotificationPreferences} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ClubNotificationPreferences.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    muted: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    disabledTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ClubNotificationPreferences}
+ */
+proto.watchclub.ClubNotificationPreferences.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ClubNotificationPreferences;
+  return proto.watchclub.ClubNotificationPreferences.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ClubNotificationPreferences} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ClubNotificationPreferences}
+ */
+proto.watchclub.ClubNotificationPreferences.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMuted(value);
+      break;
+    case 3:
+      var values = /** @type {!Array<!proto.watchclub.NotificationType>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addDisabledTypes(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ClubNotificationPreferences.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ClubNotificationPreferences} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ClubNotificationPreferences.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMuted();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getDisabledTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ClubNotificationPreferences} returns this
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool muted = 2;
+ * @return {boolean}
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.getMuted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.ClubNotificationPreferences} returns this
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.setMuted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * repeated NotificationType disabled_types = 3;
+ * @return {!Array<!proto.watchclub.NotificationType>}
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.getDisabledTypesList = function() {
+  return /** @type {!Array<!proto.watchclub.NotificationType>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.NotificationType>} value
+ * @return {!proto.watchclub.ClubNotificationPreferences} returns this
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.setDisabledTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.watchclub.NotificationType} value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.ClubNotificationPreferences} returns this
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.addDisabledTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.ClubNotificationPreferences} returns this
+ */
+proto.watchclub.ClubNotificationPreferences.prototype.clearDisabledTypesList = function() {
+  return this.setDisabledTypesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.CreateUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateUserRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.CreateUserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateUserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.CreateUserRequest}
+ */
+proto.watchclub.CreateUserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.CreateUserRequest;
+  return proto.watchclub.CreateUserRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.CreateUserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.CreateUserRequest}
+ */
+proto.watchclub.CreateUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.CreateUserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.CreateUserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.CreateUserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateUserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.watchclub.CreateUserRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.CreateUserRequest} returns this
+ */
+proto.watchclub.CreateUserRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string email = 2;
+ * @return {string}
+ */
+proto.watchclub.CreateUserRequest.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.CreateUserRequest} returns this
+ */
+proto.watchclub.CreateUserRequest.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.CreateUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateUserResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.CreateUserResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateUserResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    user: (f = msg.getUser()) && proto.watchclub.User.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.CreateUserResponse}
+ */
+proto.watchclub.CreateUserResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.CreateUserResponse;
+  return proto.watchclub.CreateUserResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.CreateUserResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.CreateUserResponse}
+ */
+proto.watchclub.CreateUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.User;
+      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.CreateUserResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.CreateUserResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.CreateUserResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateUserResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional User user = 1;
+ * @return {?proto.watchclub.User}
+ */
+proto.watchclub.CreateUserResponse.prototype.getUser = function() {
+  return /** @type{?proto.watchclub.User} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.User, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.User|undefined} value
+ * @return {!proto.watchclub.CreateUserResponse} returns this
+*/
+proto.watchclub.CreateUserResponse.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.CreateUserResponse} returns this
+ */
+proto.watchclub.CreateUserResponse.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.CreateUserResponse.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.CreateClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateClubRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.CreateClubRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateClubRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    maxPicksPerMember: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    scheduleIntervalQuantity: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    scheduleIntervalUnit: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.CreateClubRequest}
+ */
+proto.watchclub.CreateClubRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.CreateClubRequest;
+  return proto.watchclub.CreateClubRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.CreateClubRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.CreateClubRequest}
+ */
+proto.watchclub.CreateClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartDate(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxPicksPerMember(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScheduleIntervalQuantity(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (reader.readEnum());
+      msg.setScheduleIntervalUnit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.CreateClubRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.CreateClubRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.CreateClubRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateClubRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStartDate();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxPicksPerMember();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getScheduleIntervalQuantity();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getScheduleIntervalUnit();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.watchclub.CreateClubRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+ */
+proto.watchclub.CreateClubRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start_date = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.watchclub.CreateClubRequest.prototype.getStartDate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+*/
+proto.watchclub.CreateClubRequest.prototype.setStartDate = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+ */
+proto.watchclub.CreateClubRequest.prototype.clearStartDate = function() {
+  return this.setStartDate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.CreateClubRequest.prototype.hasStartDate = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional int32 max_picks_per_member = 3;
+ * @return {number}
+ */
+proto.watchclub.CreateClubRequest.prototype.getMaxPicksPerMember = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+ */
+proto.watchclub.CreateClubRequest.prototype.setMaxPicksPerMember = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 schedule_interval_quantity = 4;
+ * @return {number}
+ */
+proto.watchclub.CreateClubRequest.prototype.getScheduleIntervalQuantity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+ */
+proto.watchclub.CreateClubRequest.prototype.setScheduleIntervalQuantity = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional ScheduleIntervalUnit schedule_interval_unit = 5;
+ * @return {!proto.watchclub.ScheduleIntervalUnit}
+ */
+proto.watchclub.CreateClubRequest.prototype.getScheduleIntervalUnit = function() {
+  return /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.watchclub.ScheduleIntervalUnit} value
+ * @return {!proto.watchclub.CreateClubRequest} returns this
+ */
+proto.watchclub.CreateClubRequest.prototype.setScheduleIntervalUnit = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.CreateClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateClubResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.CreateClubResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateClubResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.CreateClubResponse}
+ */
+proto.watchclub.CreateClubResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.CreateClubResponse;
+  return proto.watchclub.CreateClubResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.CreateClubResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.CreateClubResponse}
+ */
+proto.watchclub.CreateClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.CreateClubResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.CreateClubResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.CreateClubResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.CreateClubResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
+ */
+proto.watchclub.CreateClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.CreateClubResponse} returns this
+*/
+proto.watchclub.CreateClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.CreateClubResponse} returns this
+ */
+proto.watchclub.CreateClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.CreateClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.JoinClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.JoinClubRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.JoinClubRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.JoinClubRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.JoinClubRequest}
+ */
+proto.watchclub.JoinClubRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.JoinClubRequest;
+  return proto.watchclub.JoinClubRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.JoinClubRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.JoinClubRequest}
+ */
+proto.watchclub.JoinClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.JoinClubRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.JoinClubRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.JoinClubRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.JoinClubRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.JoinClubRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.JoinClubRequest} returns this
+ */
+proto.watchclub.JoinClubRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.watchclub.JoinClubRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.JoinClubRequest} returns this
+ */
+proto.watchclub.JoinClubRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.JoinClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.JoinClubResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.JoinClubResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.JoinClubResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.JoinClubResponse}
+ */
+proto.watchclub.JoinClubResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.JoinClubResponse;
+  return proto.watchclub.JoinClubResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.JoinClubResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.JoinClubResponse}
+ */
+proto.watchclub.JoinClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.JoinClubResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.JoinClubResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.JoinClubResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.JoinClubResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
+ */
+proto.watchclub.JoinClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.JoinClubResponse} returns this
+*/
+proto.watchclub.JoinClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.JoinClubResponse} returns this
+ */
+proto.watchclub.JoinClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.JoinClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.AddPickRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.AddPickRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.AddPickRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.AddPickRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    title: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    notes: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    link: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.AddPickRequest}
+ */
+proto.watchclub.AddPickRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.AddPickRequest;
+  return proto.watchclub.AddPickRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.AddPickRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.AddPickRequest}
+ */
+proto.watchclub.AddPickRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTitle(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setYear(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNotes(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLink(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.AddPickRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.AddPickRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.AddPickRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.AddPickRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTitle();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getNotes();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLink();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.AddPickRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.watchclub.AddPickRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string title = 3;
+ * @return {string}
+ */
+proto.watchclub.AddPickRequest.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setTitle = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int32 year = 4;
+ * @return {number}
+ */
+proto.watchclub.AddPickRequest.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string notes = 5;
+ * @return {string}
+ */
+proto.watchclub.AddPickRequest.prototype.getNotes = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setNotes = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string link = 6;
+ * @return {string}
+ */
+proto.watchclub.AddPickRequest.prototype.getLink = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AddPickRequest} returns this
+ */
+proto.watchclub.AddPickRequest.prototype.setLink = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.AddPickResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.AddPickResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.AddPickResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.AddPickResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pick: (f = msg.getPick()) && proto.watchclub.Pick.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.AddPickResponse}
+ */
+proto.watchclub.AddPickResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.AddPickResponse;
+  return proto.watchclub.AddPickResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.AddPickResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.AddPickResponse}
+ */
+proto.watchclub.AddPickResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.setPick(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.AddPickResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.AddPickResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.AddPickResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.AddPickResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPick();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Pick.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Pick pick = 1;
+ * @return {?proto.watchclub.Pick}
+ */
+proto.watchclub.AddPickResponse.prototype.getPick = function() {
+  return /** @type{?proto.watchclub.Pick} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Pick, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.Pick|undefined} value
+ * @return {!proto.watchclub.AddPickResponse} returns this
+*/
+proto.watchclub.AddPickResponse.prototype.setPick = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.AddPickResponse} returns this
+ */
+proto.watchclub.AddPickResponse.prototype.clearPick = function() {
+  return this.setPick(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.AddPickResponse.prototype.hasPick = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.DeletePickRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeletePickRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.DeletePickRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeletePickRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pickId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.DeletePickRequest}
+ */
+proto.watchclub.DeletePickRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.DeletePickRequest;
+  return proto.watchclub.DeletePickRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.DeletePickRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.DeletePickRequest}
+ */
+proto.watchclub.DeletePickRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPickId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.DeletePickRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.DeletePickRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.DeletePickRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeletePickRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPickId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string pick_id = 1;
+ * @return {string}
+ */
+proto.watchclub.DeletePickRequest.prototype.getPickId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.DeletePickRequest} returns this
+ */
+proto.watchclub.DeletePickRequest.prototype.setPickId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.watchclub.DeletePickRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.DeletePickRequest} returns this
+ */
+proto.watchclub.DeletePickRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.DeletePickResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeletePickResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.DeletePickResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeletePickResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.DeletePickResponse}
+ */
+proto.watchclub.DeletePickResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.DeletePickResponse;
+  return proto.watchclub.DeletePickResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.DeletePickResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.DeletePickResponse}
+ */
+proto.watchclub.DeletePickResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.DeletePickResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.DeletePickResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.DeletePickResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeletePickResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.watchclub.DeletePickResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.DeletePickResponse} returns this
+ */
+proto.watchclub.DeletePickResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetClubRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetClubRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetClubRequest}
+ */
+proto.watchclub.GetClubRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetClubRequest;
+  return proto.watchclub.GetClubRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetClubRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetClubRequest}
+ */
+proto.watchclub.GetClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetClubRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetClubRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetClubRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.GetClubRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetClubRequest} returns this
+ */
+proto.watchclub.GetClubRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.GetClubResponse.repeatedFields_ = [2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetClubResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetClubResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f),
+    membersList: jspb.Message.toObjectList(msg.getMembersList(),
+    proto.watchclub.User.toObject, includeInstance),
+    picksList: jspb.Message.toObjectList(msg.getPicksList(),
+    proto.watchclub.Pick.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetClubResponse}
+ */
+proto.watchclub.GetClubResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetClubResponse;
+  return proto.watchclub.GetClubResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetClubResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetClubResponse}
+ */
+proto.watchclub.GetClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
+      break;
+    case 2:
+      var value = new proto.watchclub.User;
+      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
+      msg.addMembers(value);
+      break;
+    case 3:
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.addPicks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetClubResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetClubResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetClubResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
+  f = message.getMembersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.watchclub.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getPicksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.watchclub.Pick.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
+ */
+proto.watchclub.GetClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.GetClubResponse} returns this
+*/
+proto.watchclub.GetClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.GetClubResponse} returns this
+ */
+proto.watchclub.GetClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.GetClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated User members = 2;
+ * @return {!Array<!proto.watchclub.User>}
+ */
+proto.watchclub.GetClubResponse.prototype.getMembersList = function() {
+  return /** @type{!Array<!proto.watchclub.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.User, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.User>} value
+ * @return {!proto.watchclub.GetClubResponse} returns this
+*/
+proto.watchclub.GetClubResponse.prototype.setMembersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.User}
+ */
+proto.watchclub.GetClubResponse.prototype.addMembers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.watchclub.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.GetClubResponse} returns this
+ */
+proto.watchclub.GetClubResponse.prototype.clearMembersList = function() {
+  return this.setMembersList([]);
+};
+
+
+/**
+ * repeated Pick picks = 3;
+ * @return {!Array<!proto.watchclub.Pick>}
+ */
+proto.watchclub.GetClubResponse.prototype.getPicksList = function() {
+  return /** @type{!Array<!proto.watchclub.Pick>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Pick, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.Pick>} value
+ * @return {!proto.watchclub.GetClubResponse} returns this
+*/
+proto.watchclub.GetClubResponse.prototype.setPicksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.Pick=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Pick}
+ */
+proto.watchclub.GetClubResponse.prototype.addPicks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.watchclub.Pick, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.GetClubResponse} returns this
+ */
+proto.watchclub.GetClubResponse.prototype.clearPicksList = function() {
+  return this.setPicksList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.StartClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.StartClubRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.StartClubRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.StartClubRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.StartClubRequest}
+ */
+proto.watchclub.StartClubRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.StartClubRequest;
+  return proto.watchclub.StartClubRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.StartClubRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.StartClubRequest}
+ */
+proto.watchclub.StartClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.StartClubRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.StartClubRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.StartClubRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.StartClubRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.StartClubRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.StartClubRequest} returns this
+ */
+proto.watchclub.StartClubRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.StartClubResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.StartClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.StartClubResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.StartClubResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.StartClubResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.watchclub.ScheduledPick.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.StartClubResponse}
+ */
+proto.watchclub.StartClubResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.StartClubResponse;
+  return proto.watchclub.StartClubResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.StartClubResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.StartClubResponse}
+ */
+proto.watchclub.StartClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
+      break;
+    case 2:
+      var value = new proto.watchclub.ScheduledPick;
+      reader.readMessage(value,proto.watchclub.ScheduledPick.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.StartClubResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.StartClubResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.StartClubResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.StartClubResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.watchclub.ScheduledPick.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
+ */
+proto.watchclub.StartClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.StartClubResponse} returns this
+*/
+proto.watchclub.StartClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.StartClubResponse} returns this
+ */
+proto.watchclub.StartClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.StartClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated ScheduledPick assignments = 2;
+ * @return {!Array<!proto.watchclub.ScheduledPick>}
+ */
+proto.watchclub.StartClubResponse.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.watchclub.ScheduledPick>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.ScheduledPick, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.ScheduledPick>} value
+ * @return {!proto.watchclub.StartClubResponse} returns this
+*/
+proto.watchclub.StartClubResponse.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.ScheduledPick=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.ScheduledPick}
+ */
+proto.watchclub.StartClubResponse.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.watchclub.ScheduledPick, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.StartClubResponse} returns this
+ */
+proto.watchclub.StartClubResponse.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetScheduledPicksRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetScheduledPicksRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetScheduledPicksRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    upcomingOnly: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetScheduledPicksRequest}
+ */
+proto.watchclub.GetScheduledPicksRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetScheduledPicksRequest;
+  return proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetScheduledPicksRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetScheduledPicksRequest}
+ */
+proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUpcomingOnly(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetScheduledPicksRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetScheduledPicksRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetScheduledPicksRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getUpcomingOnly();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetScheduledPicksRequest} returns this
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_size = 2;
+ * @return {number}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.GetScheduledPicksRequest} returns this
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string page_token = 3;
+ * @return {string}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetScheduledPicksRequest} returns this
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool upcoming_only = 4;
+ * @return {boolean}
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.getUpcomingOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.GetScheduledPicksRequest} returns this
+ */
+proto.watchclub.GetScheduledPicksRequest.prototype.setUpcomingOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.GetScheduledPicksResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetScheduledPicksResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetScheduledPicksResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetScheduledPicksResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.watchclub.ScheduledPick.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetScheduledPicksResponse}
+ */
+proto.watchclub.GetScheduledPicksResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetScheduledPicksResponse;
+  return proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetScheduledPicksResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetScheduledPicksResponse}
+ */
+proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.ScheduledPick;
+      reader.readMessage(value,proto.watchclub.ScheduledPick.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetScheduledPicksResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetScheduledPicksResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetScheduledPicksResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.watchclub.ScheduledPick.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated ScheduledPick assignments = 1;
+ * @return {!Array<!proto.watchclub.ScheduledPick>}
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.watchclub.ScheduledPick>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.ScheduledPick, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.ScheduledPick>} value
+ * @return {!proto.watchclub.GetScheduledPicksResponse} returns this
+*/
+proto.watchclub.GetScheduledPicksResponse.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.ScheduledPick=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.ScheduledPick}
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.ScheduledPick, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.GetScheduledPicksResponse} returns this
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetScheduledPicksResponse} returns this
+ */
+proto.watchclub.GetScheduledPicksResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.SendLoginEmailRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.SendLoginEmailRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.SendLoginEmailRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.SendLoginEmailRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    email: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.SendLoginEmailRequest}
+ */
+proto.watchclub.SendLoginEmailRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.SendLoginEmailRequest;
+  return proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.SendLoginEmailRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.SendLoginEmailRequest}
+ */
+proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.SendLoginEmailRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.SendLoginEmailRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.SendLoginEmailRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.SendLoginEmailRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string email = 1;
+ * @return {string}
+ */
+proto.watchclub.SendLoginEmailRequest.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.SendLoginEmailRequest} returns this
+ */
+proto.watchclub.SendLoginEmailRequest.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.SendLoginEmailResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.SendLoginEmailResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.SendLoginEmailResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.SendLoginEmailResponse}
+ */
+proto.watchclub.SendLoginEmailResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.SendLoginEmailResponse;
+  return proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.SendLoginEmailResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.SendLoginEmailResponse}
+ */
+proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.SendLoginEmailResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.SendLoginEmailResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.SendLoginEmailResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.SendLoginEmailResponse} returns this
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.SendLoginEmailResponse} returns this
+ */
+proto.watchclub.SendLoginEmailResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetUserRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetUserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetUserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetUserRequest}
+ */
+proto.watchclub.GetUserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetUserRequest;
+  return proto.watchclub.GetUserRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetUserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetUserRequest}
+ */
+proto.watchclub.GetUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetUserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetUserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetUserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetUserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string user_id = 1;
+ * @return {string}
+ */
+proto.watchclub.GetUserRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetUserRequest} returns this
+ */
+proto.watchclub.GetUserRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetUserResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetUserResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetUserResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    user: (f = msg.getUser()) && proto.watchclub.User.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetUserResponse}
+ */
+proto.watchclub.GetUserResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetUserResponse;
+  return proto.watchclub.GetUserResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetUserResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetUserResponse}
+ */
+proto.watchclub.GetUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.User;
+      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetUserResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetUserResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetUserResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetUserResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional User user = 1;
+ * @return {?proto.watchclub.User}
+ */
+proto.watchclub.GetUserResponse.prototype.getUser = function() {
+  return /** @type{?proto.watchclub.User} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.User, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.User|undefined} value
+ * @return {!proto.watchclub.GetUserResponse} returns this
+*/
+proto.watchclub.GetUserResponse.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.GetUserResponse} returns this
+ */
+proto.watchclub.GetUserResponse.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.GetUserResponse.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetClubCalendarRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetClubCalendarRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetClubCalendarRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubCalendarRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetClubCalendarRequest}
+ */
+proto.watchclub.GetClubCalendarRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetClubCalendarRequest;
+  return proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetClubCalendarRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetClubCalendarRequest}
+ */
+proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetClubCalendarRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetClubCalendarRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetClubCalendarRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubCalendarRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string club_id = 1;
+ * @return {string}
+ */
+proto.watchclub.GetClubCalendarRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetClubCalendarRequest} returns this
+ */
+proto.watchclub.GetClubCalendarRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.GetClubCalendarResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetClubCalendarResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.GetClubCalendarResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubCalendarResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    icsData: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.GetClubCalendarResponse}
+ */
+proto.watchclub.GetClubCalendarResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.GetClubCalendarResponse;
+  return proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.GetClubCalendarResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.GetClubCalendarResponse}
+ */
+proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIcsData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.GetClubCalendarResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.GetClubCalendarResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.GetClubCalendarResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.GetClubCalendarResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIcsData();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ics_data = 1;
+ * @return {string}
+ */
+proto.watchclub.GetClubCalendarResponse.prototype.getIcsData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetClubCalendarResponse} returns this
+ */
+proto.watchclub.GetClubCalendarResponse.prototype.setIcsData = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListUserClubsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ListUserClubsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ListUserClubsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    started: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ListUserClubsRequest}
+ */
+proto.watchclub.ListUserClubsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ListUserClubsRequest;
+  return proto.watchclub.ListUserClubsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ListUserClubsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ListUserClubsRequest}
+ */
+proto.watchclub.ListUserClubsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStarted(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ListUserClubsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ListUserClubsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ListUserClubsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string user_id = 1;
+ * @return {string}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ */
+proto.watchclub.ListUserClubsRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_size = 2;
+ * @return {number}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ */
+proto.watchclub.ListUserClubsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string page_token = 3;
+ * @return {string}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ */
+proto.watchclub.ListUserClubsRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool started = 4;
+ * @return {boolean}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.getStarted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ */
+proto.watchclub.ListUserClubsRequest.prototype.setStarted = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ */
+proto.watchclub.ListUserClubsRequest.prototype.clearStarted = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.ListUserClubsRequest.prototype.hasStarted = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.ListUserClubsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ListUserClubsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListUserClubsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ListUserClubsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ListUserClubsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubsList: jspb.Message.toObjectList(msg.getClubsList(),
+    proto.watchclub.Club.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ListUserClubsResponse}
+ */
+proto.watchclub.ListUserClubsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ListUserClubsResponse;
+  return proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ListUserClubsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ListUserClubsResponse}
+ */
+proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.addClubs(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.watchclub.ListUserClubsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ListUserClubsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ListUserClubsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Club.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListUserClubsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getClubsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
     );
   }
-  f = message.getName();
+  f = message.getNextPageToken();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getMemberIdsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      3,
-      f
-    );
-  }
-  f = message.getStartDate();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getStarted();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getCreatedAt();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getMaxPicksPerMember();
-  if (f !== 0) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = message.getScheduleIntervalQuantity();
-  if (f !== 0) {
-    writer.writeInt32(
-      8,
-      f
-    );
-  }
-  f = message.getScheduleIntervalUnit();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated Club clubs = 1;
+ * @return {!Array<!proto.watchclub.Club>}
  */
-proto.watchclub.Club.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.ListUserClubsResponse.prototype.getClubsList = function() {
+  return /** @type{!Array<!proto.watchclub.Club>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Club, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Club} returns this
- */
-proto.watchclub.Club.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.watchclub.Club>} value
+ * @return {!proto.watchclub.ListUserClubsResponse} returns this
+*/
+proto.watchclub.ListUserClubsResponse.prototype.setClubsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * @param {!proto.watchclub.Club=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Club}
  */
-proto.watchclub.Club.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.watchclub.ListUserClubsResponse.prototype.addClubs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.Club, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Club} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.ListUserClubsResponse} returns this
  */
-proto.watchclub.Club.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.watchclub.ListUserClubsResponse.prototype.clearClubsList = function() {
+  return this.setClubsList([]);
 };
 
 
 /**
- * repeated string member_ids = 3;
- * @return {!Array<string>}
+ * optional string next_page_token = 2;
+ * @return {string}
  */
-proto.watchclub.Club.prototype.getMemberIdsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.watchclub.ListUserClubsResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.watchclub.Club} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.ListUserClubsResponse} returns this
  */
-proto.watchclub.Club.prototype.setMemberIdsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.watchclub.ListUserClubsResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.watchclub.Club} returns this
- */
-proto.watchclub.Club.prototype.addMemberIds = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.Club} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.watchclub.Club.prototype.clearMemberIdsList = function() {
-  return this.setMemberIdsList([]);
+proto.watchclub.ListPicksRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListPicksRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional google.protobuf.Timestamp start_date = 4;
- * @return {?proto.google.protobuf.Timestamp}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ListPicksRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Club.prototype.getStartDate = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+proto.watchclub.ListPicksRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.Club} returns this
-*/
-proto.watchclub.Club.prototype.setStartDate = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ListPicksRequest}
+ */
+proto.watchclub.ListPicksRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ListPicksRequest;
+  return proto.watchclub.ListPicksRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.Club} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ListPicksRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ListPicksRequest}
  */
-proto.watchclub.Club.prototype.clearStartDate = function() {
-  return this.setStartDate(undefined);
+proto.watchclub.ListPicksRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.Club.prototype.hasStartDate = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.watchclub.ListPicksRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ListPicksRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional bool started = 5;
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ListPicksRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Club.prototype.getStarted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.watchclub.ListPicksRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.watchclub.Club} returns this
+ * optional string club_id = 1;
+ * @return {string}
  */
-proto.watchclub.Club.prototype.setStarted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.watchclub.ListPicksRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional google.protobuf.Timestamp created_at = 6;
- * @return {?proto.google.protobuf.Timestamp}
+ * @param {string} value
+ * @return {!proto.watchclub.ListPicksRequest} returns this
  */
-proto.watchclub.Club.prototype.getCreatedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.Club} returns this
-*/
-proto.watchclub.Club.prototype.setCreatedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+proto.watchclub.ListPicksRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.Club} returns this
+ * optional string user_id = 2;
+ * @return {string}
  */
-proto.watchclub.Club.prototype.clearCreatedAt = function() {
-  return this.setCreatedAt(undefined);
+proto.watchclub.ListPicksRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.watchclub.ListPicksRequest} returns this
  */
-proto.watchclub.Club.prototype.hasCreatedAt = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.watchclub.ListPicksRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional int32 max_picks_per_member = 7;
+ * optional int32 page_size = 3;
  * @return {number}
  */
-proto.watchclub.Club.prototype.getMaxPicksPerMember = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.watchclub.ListPicksRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.watchclub.Club} returns this
+ * @return {!proto.watchclub.ListPicksRequest} returns this
  */
-proto.watchclub.Club.prototype.setMaxPicksPerMember = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.watchclub.ListPicksRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional int32 schedule_interval_quantity = 8;
- * @return {number}
+ * optional string page_token = 4;
+ * @return {string}
  */
-proto.watchclub.Club.prototype.getScheduleIntervalQuantity = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.watchclub.ListPicksRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.watchclub.Club} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.ListPicksRequest} returns this
  */
-proto.watchclub.Club.prototype.setScheduleIntervalQuantity = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.watchclub.ListPicksRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
-/**
- * optional ScheduleIntervalUnit schedule_interval_unit = 9;
- * @return {!proto.watchclub.ScheduleIntervalUnit}
- */
-proto.watchclub.Club.prototype.getScheduleIntervalUnit = function() {
-  return /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
-};
-
 
 /**
- * @param {!proto.watchclub.ScheduleIntervalUnit} value
- * @return {!proto.watchclub.Club} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.watchclub.Club.prototype.setScheduleIntervalUnit = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
-};
-
-
+proto.watchclub.ListPicksResponse.repeatedFields_ = [1];
 
 
 
@@ -1133,8 +8007,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.User.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.User.toObject(opt_includeInstance, this);
+proto.watchclub.ListPicksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListPicksResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1143,16 +8017,15 @@ proto.watchclub.User.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.User} msg The msg instance to transform.
+ * @param {!proto.watchclub.ListPicksResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.User.toObject = function(includeInstance, msg) {
+proto.watchclub.ListPicksResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    picksList: jspb.Message.toObjectList(msg.getPicksList(),
+    proto.watchclub.Pick.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1166,23 +8039,23 @@ proto.watchclub.User.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.User}
+ * @return {!proto.watchclub.ListPicksResponse}
  */
-proto.watchclub.User.deserializeBinary = function(bytes) {
+proto.watchclub.ListPicksResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.User;
-  return proto.watchclub.User.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ListPicksResponse;
+  return proto.watchclub.ListPicksResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.User} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ListPicksResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.User}
+ * @return {!proto.watchclub.ListPicksResponse}
  */
-proto.watchclub.User.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ListPicksResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1190,21 +8063,13 @@ proto.watchclub.User.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.addPicks(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
-      break;
-    case 4:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreatedAt(value);
+      msg.setNextPageToken(value);
       break;
     default:
       reader.skipField();
@@ -1219,9 +8084,9 @@ proto.watchclub.User.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.User.prototype.serializeBinary = function() {
+proto.watchclub.ListPicksResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.User.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ListPicksResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1229,132 +8094,213 @@ proto.watchclub.User.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.User} message
+ * @param {!proto.watchclub.ListPicksResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.User.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListPicksResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getPicksList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.watchclub.Pick.serializeBinaryToWriter
     );
   }
-  f = message.getName();
+  f = message.getNextPageToken();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getEmail();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getCreatedAt();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated Pick picks = 1;
+ * @return {!Array<!proto.watchclub.Pick>}
  */
-proto.watchclub.User.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.ListPicksResponse.prototype.getPicksList = function() {
+  return /** @type{!Array<!proto.watchclub.Pick>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Pick, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.User} returns this
+ * @param {!Array<!proto.watchclub.Pick>} value
+ * @return {!proto.watchclub.ListPicksResponse} returns this
+*/
+proto.watchclub.ListPicksResponse.prototype.setPicksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.Pick=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Pick}
  */
-proto.watchclub.User.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.watchclub.ListPicksResponse.prototype.addPicks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.Pick, opt_index);
 };
 
 
 /**
- * optional string name = 2;
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.ListPicksResponse} returns this
+ */
+proto.watchclub.ListPicksResponse.prototype.clearPicksList = function() {
+  return this.setPicksList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
  * @return {string}
  */
-proto.watchclub.User.prototype.getName = function() {
+proto.watchclub.ListPicksResponse.prototype.getNextPageToken = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.User} returns this
+ * @return {!proto.watchclub.ListPicksResponse} returns this
  */
-proto.watchclub.User.prototype.setName = function(value) {
+proto.watchclub.ListPicksResponse.prototype.setNextPageToken = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string email = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.DeleteClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteClubRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.DeleteClubRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeleteClubRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.DeleteClubRequest}
  */
-proto.watchclub.User.prototype.getEmail = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.watchclub.DeleteClubRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.DeleteClubRequest;
+  return proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.User} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.DeleteClubRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.DeleteClubRequest}
  */
-proto.watchclub.User.prototype.setEmail = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional google.protobuf.Timestamp created_at = 4;
- * @return {?proto.google.protobuf.Timestamp}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.User.prototype.getCreatedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+proto.watchclub.DeleteClubRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.DeleteClubRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.User} returns this
-*/
-proto.watchclub.User.prototype.setCreatedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.DeleteClubRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.DeleteClubRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.User} returns this
+ * optional string club_id = 1;
+ * @return {string}
  */
-proto.watchclub.User.prototype.clearCreatedAt = function() {
-  return this.setCreatedAt(undefined);
+proto.watchclub.DeleteClubRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.watchclub.DeleteClubRequest} returns this
  */
-proto.watchclub.User.prototype.hasCreatedAt = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.watchclub.DeleteClubRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1374,8 +8320,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.Pick.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.Pick.toObject(opt_includeInstance, this);
+proto.watchclub.DeleteClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteClubResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1384,20 +8330,13 @@ proto.watchclub.Pick.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.Pick} msg The msg instance to transform.
+ * @param {!proto.watchclub.DeleteClubResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Pick.toObject = function(includeInstance, msg) {
+proto.watchclub.DeleteClubResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    clubId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    userId: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    title: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    notes: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    link: jspb.Message.getFieldWithDefault(msg, 8, "")
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -1411,23 +8350,23 @@ proto.watchclub.Pick.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.Pick}
+ * @return {!proto.watchclub.DeleteClubResponse}
  */
-proto.watchclub.Pick.deserializeBinary = function(bytes) {
+proto.watchclub.DeleteClubResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.Pick;
-  return proto.watchclub.Pick.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.DeleteClubResponse;
+  return proto.watchclub.DeleteClubResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.Pick} msg The message object to deserialize into.
+ * @param {!proto.watchclub.DeleteClubResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.Pick}
+ * @return {!proto.watchclub.DeleteClubResponse}
  */
-proto.watchclub.Pick.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.DeleteClubResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1435,37 +8374,8 @@ proto.watchclub.Pick.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUserId(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTitle(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setYear(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNotes(value);
-      break;
-    case 7:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreatedAt(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLink(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     default:
       reader.skipField();
@@ -1480,9 +8390,9 @@ proto.watchclub.Pick.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.Pick.prototype.serializeBinary = function() {
+proto.watchclub.DeleteClubResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.Pick.serializeBinaryToWriter(this, writer);
+  proto.watchclub.DeleteClubResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1490,232 +8400,357 @@ proto.watchclub.Pick.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.Pick} message
+ * @param {!proto.watchclub.DeleteClubResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Pick.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.DeleteClubResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getClubId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getUserId();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getTitle();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getYear();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getNotes();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getCreatedAt();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getLink();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.watchclub.DeleteClubResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.watchclub.DeleteClubResponse} returns this
+ */
+proto.watchclub.DeleteClubResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ListDeletedClubsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListDeletedClubsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ListDeletedClubsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.watchclub.ListDeletedClubsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
 };
+}
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ListDeletedClubsRequest}
  */
-proto.watchclub.Pick.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.ListDeletedClubsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ListDeletedClubsRequest;
+  return proto.watchclub.ListDeletedClubsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ListDeletedClubsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ListDeletedClubsRequest}
  */
-proto.watchclub.Pick.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.watchclub.ListDeletedClubsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string club_id = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.Pick.prototype.getClubId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.watchclub.ListDeletedClubsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ListDeletedClubsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ListDeletedClubsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Pick.prototype.setClubId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.watchclub.ListDeletedClubsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string user_id = 3;
+ * optional string user_id = 1;
  * @return {string}
  */
-proto.watchclub.Pick.prototype.getUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.watchclub.ListDeletedClubsRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * @return {!proto.watchclub.ListDeletedClubsRequest} returns this
  */
-proto.watchclub.Pick.prototype.setUserId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.watchclub.ListDeletedClubsRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * optional string title = 4;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.watchclub.Pick.prototype.getTitle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.watchclub.ListDeletedClubsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.watchclub.ListDeletedClubsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListDeletedClubsResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.ListDeletedClubsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Pick.prototype.setTitle = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.watchclub.ListDeletedClubsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clubsList: jspb.Message.toObjectList(msg.getClubsList(),
+    proto.watchclub.Club.toObject, includeInstance),
+    retentionDays: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int32 year = 5;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.ListDeletedClubsResponse}
  */
-proto.watchclub.Pick.prototype.getYear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.watchclub.ListDeletedClubsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.ListDeletedClubsResponse;
+  return proto.watchclub.ListDeletedClubsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.watchclub.Pick} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.ListDeletedClubsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.ListDeletedClubsResponse}
  */
-proto.watchclub.Pick.prototype.setYear = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.watchclub.ListDeletedClubsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.addClubs(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRetentionDays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string notes = 6;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.Pick.prototype.getNotes = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.watchclub.ListDeletedClubsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.ListDeletedClubsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.ListDeletedClubsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.Pick.prototype.setNotes = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.watchclub.ListDeletedClubsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClubsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
+  f = message.getRetentionDays();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Timestamp created_at = 7;
- * @return {?proto.google.protobuf.Timestamp}
+ * repeated Club clubs = 1;
+ * @return {!Array<!proto.watchclub.Club>}
  */
-proto.watchclub.Pick.prototype.getCreatedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+proto.watchclub.ListDeletedClubsResponse.prototype.getClubsList = function() {
+  return /** @type{!Array<!proto.watchclub.Club>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Club, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.Pick} returns this
+ * @param {!Array<!proto.watchclub.Club>} value
+ * @return {!proto.watchclub.ListDeletedClubsResponse} returns this
 */
-proto.watchclub.Pick.prototype.setCreatedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+proto.watchclub.ListDeletedClubsResponse.prototype.setClubsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.Pick} returns this
+ * @param {!proto.watchclub.Club=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Club}
  */
-proto.watchclub.Pick.prototype.clearCreatedAt = function() {
-  return this.setCreatedAt(undefined);
+proto.watchclub.ListDeletedClubsResponse.prototype.addClubs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.Club, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.ListDeletedClubsResponse} returns this
  */
-proto.watchclub.Pick.prototype.hasCreatedAt = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.watchclub.ListDeletedClubsResponse.prototype.clearClubsList = function() {
+  return this.setClubsList([]);
 };
 
 
 /**
- * optional string link = 8;
- * @return {string}
+ * optional int32 retention_days = 2;
+ * @return {number}
  */
-proto.watchclub.Pick.prototype.getLink = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.watchclub.ListDeletedClubsResponse.prototype.getRetentionDays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.Pick} returns this
+ * @param {number} value
+ * @return {!proto.watchclub.ListDeletedClubsResponse} returns this
  */
-proto.watchclub.Pick.prototype.setLink = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.watchclub.ListDeletedClubsResponse.prototype.setRetentionDays = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -1735,8 +8770,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.ScheduledPick.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.ScheduledPick.toObject(opt_includeInstance, this);
+proto.watchclub.RestoreClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RestoreClubRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1745,17 +8780,14 @@ proto.watchclub.ScheduledPick.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.ScheduledPick} msg The msg instance to transform.
+ * @param {!proto.watchclub.RestoreClubRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ScheduledPick.toObject = function(includeInstance, msg) {
+proto.watchclub.RestoreClubRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    clubId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    sequenceNumber: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    pick: (f = msg.getPick()) && proto.watchclub.Pick.toObject(includeInstance, f)
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1769,23 +8801,23 @@ proto.watchclub.ScheduledPick.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.ScheduledPick}
+ * @return {!proto.watchclub.RestoreClubRequest}
  */
-proto.watchclub.ScheduledPick.deserializeBinary = function(bytes) {
+proto.watchclub.RestoreClubRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.ScheduledPick;
-  return proto.watchclub.ScheduledPick.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.RestoreClubRequest;
+  return proto.watchclub.RestoreClubRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.ScheduledPick} msg The message object to deserialize into.
+ * @param {!proto.watchclub.RestoreClubRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.ScheduledPick}
+ * @return {!proto.watchclub.RestoreClubRequest}
  */
-proto.watchclub.ScheduledPick.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.RestoreClubRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1794,25 +8826,11 @@ proto.watchclub.ScheduledPick.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setClubId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setSequenceNumber(value);
-      break;
-    case 4:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStartDate(value);
-      break;
-    case 5:
-      var value = new proto.watchclub.Pick;
-      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
-      msg.setPick(value);
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -1827,9 +8845,9 @@ proto.watchclub.ScheduledPick.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.ScheduledPick.prototype.serializeBinary = function() {
+proto.watchclub.RestoreClubRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.ScheduledPick.serializeBinaryToWriter(this, writer);
+  proto.watchclub.RestoreClubRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1837,168 +8855,204 @@ proto.watchclub.ScheduledPick.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.ScheduledPick} message
+ * @param {!proto.watchclub.RestoreClubRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ScheduledPick.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.RestoreClubRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getClubId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getClubId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getSequenceNumber();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getStartDate();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getPick();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.watchclub.Pick.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string club_id = 1;
  * @return {string}
  */
-proto.watchclub.ScheduledPick.prototype.getId = function() {
+proto.watchclub.RestoreClubRequest.prototype.getClubId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * @return {!proto.watchclub.RestoreClubRequest} returns this
  */
-proto.watchclub.ScheduledPick.prototype.setId = function(value) {
+proto.watchclub.RestoreClubRequest.prototype.setClubId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string club_id = 2;
+ * optional string user_id = 2;
  * @return {string}
  */
-proto.watchclub.ScheduledPick.prototype.getClubId = function() {
+proto.watchclub.RestoreClubRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * @return {!proto.watchclub.RestoreClubRequest} returns this
  */
-proto.watchclub.ScheduledPick.prototype.setClubId = function(value) {
+proto.watchclub.RestoreClubRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional int32 sequence_number = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.watchclub.ScheduledPick.prototype.getSequenceNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.watchclub.RestoreClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RestoreClubResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.RestoreClubResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ScheduledPick.prototype.setSequenceNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.watchclub.RestoreClubResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional google.protobuf.Timestamp start_date = 4;
- * @return {?proto.google.protobuf.Timestamp}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.RestoreClubResponse}
  */
-proto.watchclub.ScheduledPick.prototype.getStartDate = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+proto.watchclub.RestoreClubResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.RestoreClubResponse;
+  return proto.watchclub.RestoreClubResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.ScheduledPick} returns this
-*/
-proto.watchclub.ScheduledPick.prototype.setStartDate = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.RestoreClubResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.RestoreClubResponse}
+ */
+proto.watchclub.RestoreClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.ScheduledPick.prototype.clearStartDate = function() {
-  return this.setStartDate(undefined);
+proto.watchclub.RestoreClubResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.RestoreClubResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.RestoreClubResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ScheduledPick.prototype.hasStartDate = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.watchclub.RestoreClubResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional Pick pick = 5;
- * @return {?proto.watchclub.Pick}
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
  */
-proto.watchclub.ScheduledPick.prototype.getPick = function() {
-  return /** @type{?proto.watchclub.Pick} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Pick, 5));
+proto.watchclub.RestoreClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
 };
 
 
 /**
- * @param {?proto.watchclub.Pick|undefined} value
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.RestoreClubResponse} returns this
 */
-proto.watchclub.ScheduledPick.prototype.setPick = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+proto.watchclub.RestoreClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.watchclub.ScheduledPick} returns this
+ * @return {!proto.watchclub.RestoreClubResponse} returns this
  */
-proto.watchclub.ScheduledPick.prototype.clearPick = function() {
-  return this.setPick(undefined);
+proto.watchclub.RestoreClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
 };
 
 
@@ -2006,8 +9060,8 @@ proto.watchclub.ScheduledPick.prototype.clearPick = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.watchclub.ScheduledPick.prototype.hasPick = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.watchclub.RestoreClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -2027,8 +9081,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.CreateUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.CreateUserRequest.toObject(opt_includeInstance, this);
+proto.watchclub.ListDeletedPicksRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListDeletedPicksRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2037,14 +9091,14 @@ proto.watchclub.CreateUserRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.CreateUserRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.ListDeletedPicksRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateUserRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.ListDeletedPicksRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 2, "")
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2058,23 +9112,23 @@ proto.watchclub.CreateUserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.CreateUserRequest}
+ * @return {!proto.watchclub.ListDeletedPicksRequest}
  */
-proto.watchclub.CreateUserRequest.deserializeBinary = function(bytes) {
+proto.watchclub.ListDeletedPicksRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.CreateUserRequest;
-  return proto.watchclub.CreateUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ListDeletedPicksRequest;
+  return proto.watchclub.ListDeletedPicksRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.CreateUserRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ListDeletedPicksRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.CreateUserRequest}
+ * @return {!proto.watchclub.ListDeletedPicksRequest}
  */
-proto.watchclub.CreateUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ListDeletedPicksRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2083,11 +9137,11 @@ proto.watchclub.CreateUserRequest.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setClubId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -2102,9 +9156,9 @@ proto.watchclub.CreateUserRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.CreateUserRequest.prototype.serializeBinary = function() {
+proto.watchclub.ListDeletedPicksRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.CreateUserRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ListDeletedPicksRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2112,20 +9166,20 @@ proto.watchclub.CreateUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.CreateUserRequest} message
+ * @param {!proto.watchclub.ListDeletedPicksRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListDeletedPicksRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getClubId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getEmail();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -2136,42 +9190,49 @@ proto.watchclub.CreateUserRequest.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * optional string name = 1;
+ * optional string club_id = 1;
  * @return {string}
  */
-proto.watchclub.CreateUserRequest.prototype.getName = function() {
+proto.watchclub.ListDeletedPicksRequest.prototype.getClubId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.CreateUserRequest} returns this
+ * @return {!proto.watchclub.ListDeletedPicksRequest} returns this
  */
-proto.watchclub.CreateUserRequest.prototype.setName = function(value) {
+proto.watchclub.ListDeletedPicksRequest.prototype.setClubId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string email = 2;
+ * optional string user_id = 2;
  * @return {string}
  */
-proto.watchclub.CreateUserRequest.prototype.getEmail = function() {
+proto.watchclub.ListDeletedPicksRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.CreateUserRequest} returns this
+ * @return {!proto.watchclub.ListDeletedPicksRequest} returns this
  */
-proto.watchclub.CreateUserRequest.prototype.setEmail = function(value) {
+proto.watchclub.ListDeletedPicksRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.watchclub.ListDeletedPicksResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2187,8 +9248,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.CreateUserResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.CreateUserResponse.toObject(opt_includeInstance, this);
+proto.watchclub.ListDeletedPicksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListDeletedPicksResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2197,13 +9258,15 @@ proto.watchclub.CreateUserResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.CreateUserResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.ListDeletedPicksResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateUserResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.ListDeletedPicksResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    user: (f = msg.getUser()) && proto.watchclub.User.toObject(includeInstance, f)
+    picksList: jspb.Message.toObjectList(msg.getPicksList(),
+    proto.watchclub.Pick.toObject, includeInstance),
+    retentionDays: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -2217,23 +9280,23 @@ proto.watchclub.CreateUserResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.CreateUserResponse}
+ * @return {!proto.watchclub.ListDeletedPicksResponse}
  */
-proto.watchclub.CreateUserResponse.deserializeBinary = function(bytes) {
+proto.watchclub.ListDeletedPicksResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.CreateUserResponse;
-  return proto.watchclub.CreateUserResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ListDeletedPicksResponse;
+  return proto.watchclub.ListDeletedPicksResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.CreateUserResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ListDeletedPicksResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.CreateUserResponse}
+ * @return {!proto.watchclub.ListDeletedPicksResponse}
  */
-proto.watchclub.CreateUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ListDeletedPicksResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2241,9 +9304,13 @@ proto.watchclub.CreateUserResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.User;
-      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
-      msg.setUser(value);
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.addPicks(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRetentionDays(value);
       break;
     default:
       reader.skipField();
@@ -2258,9 +9325,9 @@ proto.watchclub.CreateUserResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.CreateUserResponse.prototype.serializeBinary = function() {
+proto.watchclub.ListDeletedPicksResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.CreateUserResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ListDeletedPicksResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2268,57 +9335,83 @@ proto.watchclub.CreateUserResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.CreateUserResponse} message
+ * @param {!proto.watchclub.ListDeletedPicksResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateUserResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListDeletedPicksResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPicksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
       f,
-      proto.watchclub.User.serializeBinaryToWriter
+      proto.watchclub.Pick.serializeBinaryToWriter
+    );
+  }
+  f = message.getRetentionDays();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * optional User user = 1;
- * @return {?proto.watchclub.User}
+ * repeated Pick picks = 1;
+ * @return {!Array<!proto.watchclub.Pick>}
+ */
+proto.watchclub.ListDeletedPicksResponse.prototype.getPicksList = function() {
+  return /** @type{!Array<!proto.watchclub.Pick>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Pick, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.watchclub.Pick>} value
+ * @return {!proto.watchclub.ListDeletedPicksResponse} returns this
+*/
+proto.watchclub.ListDeletedPicksResponse.prototype.setPicksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.watchclub.Pick=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.watchclub.Pick}
  */
-proto.watchclub.CreateUserResponse.prototype.getUser = function() {
-  return /** @type{?proto.watchclub.User} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.User, 1));
+proto.watchclub.ListDeletedPicksResponse.prototype.addPicks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.Pick, opt_index);
 };
 
 
 /**
- * @param {?proto.watchclub.User|undefined} value
- * @return {!proto.watchclub.CreateUserResponse} returns this
-*/
-proto.watchclub.CreateUserResponse.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.watchclub.ListDeletedPicksResponse} returns this
+ */
+proto.watchclub.ListDeletedPicksResponse.prototype.clearPicksList = function() {
+  return this.setPicksList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.CreateUserResponse} returns this
+ * optional int32 retention_days = 2;
+ * @return {number}
  */
-proto.watchclub.CreateUserResponse.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.watchclub.ListDeletedPicksResponse.prototype.getRetentionDays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.watchclub.ListDeletedPicksResponse} returns this
  */
-proto.watchclub.CreateUserResponse.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.watchclub.ListDeletedPicksResponse.prototype.setRetentionDays = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -2338,8 +9431,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.CreateClubRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.CreateClubRequest.toObject(opt_includeInstance, this);
+proto.watchclub.RestorePickRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RestorePickRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2348,17 +9441,15 @@ proto.watchclub.CreateClubRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.CreateClubRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.RestorePickRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateClubRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.RestorePickRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    maxPicksPerMember: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    scheduleIntervalQuantity: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    scheduleIntervalUnit: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    pickId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    clubId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -2372,23 +9463,23 @@ proto.watchclub.CreateClubRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.CreateClubRequest}
+ * @return {!proto.watchclub.RestorePickRequest}
  */
-proto.watchclub.CreateClubRequest.deserializeBinary = function(bytes) {
+proto.watchclub.RestorePickRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.CreateClubRequest;
-  return proto.watchclub.CreateClubRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.RestorePickRequest;
+  return proto.watchclub.RestorePickRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.CreateClubRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.RestorePickRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.CreateClubRequest}
+ * @return {!proto.watchclub.RestorePickRequest}
  */
-proto.watchclub.CreateClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.RestorePickRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2397,24 +9488,15 @@ proto.watchclub.CreateClubRequest.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setPickId(value);
       break;
     case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStartDate(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxPicksPerMember(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setScheduleIntervalQuantity(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (reader.readEnum());
-      msg.setScheduleIntervalUnit(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -2429,9 +9511,9 @@ proto.watchclub.CreateClubRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.CreateClubRequest.prototype.serializeBinary = function() {
+proto.watchclub.RestorePickRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.CreateClubRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.RestorePickRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2439,45 +9521,30 @@ proto.watchclub.CreateClubRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.CreateClubRequest} message
+ * @param {!proto.watchclub.RestorePickRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateClubRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.RestorePickRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getPickId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getStartDate();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getMaxPicksPerMember();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
       f
     );
   }
-  f = message.getScheduleIntervalQuantity();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getScheduleIntervalUnit();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
       f
     );
   }
@@ -2485,111 +9552,56 @@ proto.watchclub.CreateClubRequest.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * optional string name = 1;
+ * optional string pick_id = 1;
  * @return {string}
  */
-proto.watchclub.CreateClubRequest.prototype.getName = function() {
+proto.watchclub.RestorePickRequest.prototype.getPickId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.CreateClubRequest} returns this
+ * @return {!proto.watchclub.RestorePickRequest} returns this
  */
-proto.watchclub.CreateClubRequest.prototype.setName = function(value) {
+proto.watchclub.RestorePickRequest.prototype.setPickId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp start_date = 2;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.watchclub.CreateClubRequest.prototype.getStartDate = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.watchclub.CreateClubRequest} returns this
-*/
-proto.watchclub.CreateClubRequest.prototype.setStartDate = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.CreateClubRequest} returns this
- */
-proto.watchclub.CreateClubRequest.prototype.clearStartDate = function() {
-  return this.setStartDate(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.watchclub.CreateClubRequest.prototype.hasStartDate = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional int32 max_picks_per_member = 3;
- * @return {number}
- */
-proto.watchclub.CreateClubRequest.prototype.getMaxPicksPerMember = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.watchclub.CreateClubRequest} returns this
- */
-proto.watchclub.CreateClubRequest.prototype.setMaxPicksPerMember = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional int32 schedule_interval_quantity = 4;
- * @return {number}
+ * optional string club_id = 2;
+ * @return {string}
  */
-proto.watchclub.CreateClubRequest.prototype.getScheduleIntervalQuantity = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.watchclub.RestorePickRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.watchclub.CreateClubRequest} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.RestorePickRequest} returns this
  */
-proto.watchclub.CreateClubRequest.prototype.setScheduleIntervalQuantity = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.watchclub.RestorePickRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional ScheduleIntervalUnit schedule_interval_unit = 5;
- * @return {!proto.watchclub.ScheduleIntervalUnit}
+ * optional string user_id = 3;
+ * @return {string}
  */
-proto.watchclub.CreateClubRequest.prototype.getScheduleIntervalUnit = function() {
-  return /** @type {!proto.watchclub.ScheduleIntervalUnit} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.watchclub.RestorePickRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!proto.watchclub.ScheduleIntervalUnit} value
- * @return {!proto.watchclub.CreateClubRequest} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.RestorePickRequest} returns this
  */
-proto.watchclub.CreateClubRequest.prototype.setScheduleIntervalUnit = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.watchclub.RestorePickRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -2609,8 +9621,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.CreateClubResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.CreateClubResponse.toObject(opt_includeInstance, this);
+proto.watchclub.RestorePickResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RestorePickResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2619,13 +9631,13 @@ proto.watchclub.CreateClubResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.CreateClubResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.RestorePickResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateClubResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.RestorePickResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
+    pick: (f = msg.getPick()) && proto.watchclub.Pick.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2639,23 +9651,23 @@ proto.watchclub.CreateClubResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.CreateClubResponse}
+ * @return {!proto.watchclub.RestorePickResponse}
  */
-proto.watchclub.CreateClubResponse.deserializeBinary = function(bytes) {
+proto.watchclub.RestorePickResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.CreateClubResponse;
-  return proto.watchclub.CreateClubResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.RestorePickResponse;
+  return proto.watchclub.RestorePickResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.CreateClubResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.RestorePickResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.CreateClubResponse}
+ * @return {!proto.watchclub.RestorePickResponse}
  */
-proto.watchclub.CreateClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.RestorePickResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2663,9 +9675,9 @@ proto.watchclub.CreateClubResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Club;
-      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
-      msg.setClub(value);
+      var value = new proto.watchclub.Pick;
+      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
+      msg.setPick(value);
       break;
     default:
       reader.skipField();
@@ -2680,9 +9692,9 @@ proto.watchclub.CreateClubResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.CreateClubResponse.prototype.serializeBinary = function() {
+proto.watchclub.RestorePickResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.CreateClubResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.RestorePickResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2690,48 +9702,48 @@ proto.watchclub.CreateClubResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.CreateClubResponse} message
+ * @param {!proto.watchclub.RestorePickResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.CreateClubResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.RestorePickResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClub();
+  f = message.getPick();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.watchclub.Club.serializeBinaryToWriter
+      proto.watchclub.Pick.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Club club = 1;
- * @return {?proto.watchclub.Club}
+ * optional Pick pick = 1;
+ * @return {?proto.watchclub.Pick}
  */
-proto.watchclub.CreateClubResponse.prototype.getClub = function() {
-  return /** @type{?proto.watchclub.Club} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+proto.watchclub.RestorePickResponse.prototype.getPick = function() {
+  return /** @type{?proto.watchclub.Pick} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Pick, 1));
 };
 
 
 /**
- * @param {?proto.watchclub.Club|undefined} value
- * @return {!proto.watchclub.CreateClubResponse} returns this
+ * @param {?proto.watchclub.Pick|undefined} value
+ * @return {!proto.watchclub.RestorePickResponse} returns this
 */
-proto.watchclub.CreateClubResponse.prototype.setClub = function(value) {
+proto.watchclub.RestorePickResponse.prototype.setPick = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.watchclub.CreateClubResponse} returns this
+ * @return {!proto.watchclub.RestorePickResponse} returns this
  */
-proto.watchclub.CreateClubResponse.prototype.clearClub = function() {
-  return this.setClub(undefined);
+proto.watchclub.RestorePickResponse.prototype.clearPick = function() {
+  return this.setPick(undefined);
 };
 
 
@@ -2739,7 +9751,7 @@ proto.watchclub.CreateClubResponse.prototype.clearClub = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.watchclub.CreateClubResponse.prototype.hasClub = function() {
+proto.watchclub.RestorePickResponse.prototype.hasPick = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -2760,8 +9772,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.JoinClubRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.JoinClubRequest.toObject(opt_includeInstance, this);
+proto.watchclub.MoveScheduledPickRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.MoveScheduledPickRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2770,14 +9782,14 @@ proto.watchclub.JoinClubRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.JoinClubRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.MoveScheduledPickRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.JoinClubRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.MoveScheduledPickRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
+    scheduledPickId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    startDate: (f = msg.getStartDate()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2791,23 +9803,23 @@ proto.watchclub.JoinClubRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.JoinClubRequest}
+ * @return {!proto.watchclub.MoveScheduledPickRequest}
  */
-proto.watchclub.JoinClubRequest.deserializeBinary = function(bytes) {
+proto.watchclub.MoveScheduledPickRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.JoinClubRequest;
-  return proto.watchclub.JoinClubRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.MoveScheduledPickRequest;
+  return proto.watchclub.MoveScheduledPickRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.JoinClubRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.MoveScheduledPickRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.JoinClubRequest}
+ * @return {!proto.watchclub.MoveScheduledPickRequest}
  */
-proto.watchclub.JoinClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.MoveScheduledPickRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2816,11 +9828,12 @@ proto.watchclub.JoinClubRequest.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      msg.setScheduledPickId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUserId(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartDate(value);
       break;
     default:
       reader.skipField();
@@ -2835,9 +9848,9 @@ proto.watchclub.JoinClubRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.JoinClubRequest.prototype.serializeBinary = function() {
+proto.watchclub.MoveScheduledPickRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.JoinClubRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.MoveScheduledPickRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2845,62 +9858,82 @@ proto.watchclub.JoinClubRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.JoinClubRequest} message
+ * @param {!proto.watchclub.MoveScheduledPickRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.JoinClubRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.MoveScheduledPickRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
+  f = message.getScheduledPickId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getUserId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStartDate();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string club_id = 1;
+ * optional string scheduled_pick_id = 1;
  * @return {string}
  */
-proto.watchclub.JoinClubRequest.prototype.getClubId = function() {
+proto.watchclub.MoveScheduledPickRequest.prototype.getScheduledPickId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.JoinClubRequest} returns this
+ * @return {!proto.watchclub.MoveScheduledPickRequest} returns this
  */
-proto.watchclub.JoinClubRequest.prototype.setClubId = function(value) {
+proto.watchclub.MoveScheduledPickRequest.prototype.setScheduledPickId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string user_id = 2;
- * @return {string}
+ * optional google.protobuf.Timestamp start_date = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.watchclub.JoinClubRequest.prototype.getUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.watchclub.MoveScheduledPickRequest.prototype.getStartDate = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.JoinClubRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.MoveScheduledPickRequest} returns this
+*/
+proto.watchclub.MoveScheduledPickRequest.prototype.setStartDate = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.MoveScheduledPickRequest} returns this
  */
-proto.watchclub.JoinClubRequest.prototype.setUserId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.watchclub.MoveScheduledPickRequest.prototype.clearStartDate = function() {
+  return this.setStartDate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.MoveScheduledPickRequest.prototype.hasStartDate = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -2920,8 +9953,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.JoinClubResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.JoinClubResponse.toObject(opt_includeInstance, this);
+proto.watchclub.MoveScheduledPickResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.MoveScheduledPickResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2930,13 +9963,13 @@ proto.watchclub.JoinClubResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.JoinClubResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.MoveScheduledPickResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.JoinClubResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.MoveScheduledPickResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
+    assignment: (f = msg.getAssignment()) && proto.watchclub.ScheduledPick.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2950,23 +9983,23 @@ proto.watchclub.JoinClubResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.JoinClubResponse}
+ * @return {!proto.watchclub.MoveScheduledPickResponse}
  */
-proto.watchclub.JoinClubResponse.deserializeBinary = function(bytes) {
+proto.watchclub.MoveScheduledPickResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.JoinClubResponse;
-  return proto.watchclub.JoinClubResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.MoveScheduledPickResponse;
+  return proto.watchclub.MoveScheduledPickResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.JoinClubResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.MoveScheduledPickResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.JoinClubResponse}
+ * @return {!proto.watchclub.MoveScheduledPickResponse}
  */
-proto.watchclub.JoinClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.MoveScheduledPickResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2974,9 +10007,9 @@ proto.watchclub.JoinClubResponse.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Club;
-      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
-      msg.setClub(value);
+      var value = new proto.watchclub.ScheduledPick;
+      reader.readMessage(value,proto.watchclub.ScheduledPick.deserializeBinaryFromReader);
+      msg.setAssignment(value);
       break;
     default:
       reader.skipField();
@@ -2991,9 +10024,9 @@ proto.watchclub.JoinClubResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.JoinClubResponse.prototype.serializeBinary = function() {
+proto.watchclub.MoveScheduledPickResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.JoinClubResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.MoveScheduledPickResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3001,48 +10034,48 @@ proto.watchclub.JoinClubResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.JoinClubResponse} message
+ * @param {!proto.watchclub.MoveScheduledPickResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.JoinClubResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.MoveScheduledPickResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClub();
+  f = message.getAssignment();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.watchclub.Club.serializeBinaryToWriter
+      proto.watchclub.ScheduledPick.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Club club = 1;
- * @return {?proto.watchclub.Club}
+ * optional ScheduledPick assignment = 1;
+ * @return {?proto.watchclub.ScheduledPick}
  */
-proto.watchclub.JoinClubResponse.prototype.getClub = function() {
-  return /** @type{?proto.watchclub.Club} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+proto.watchclub.MoveScheduledPickResponse.prototype.getAssignment = function() {
+  return /** @type{?proto.watchclub.ScheduledPick} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.ScheduledPick, 1));
 };
 
 
 /**
- * @param {?proto.watchclub.Club|undefined} value
- * @return {!proto.watchclub.JoinClubResponse} returns this
+ * @param {?proto.watchclub.ScheduledPick|undefined} value
+ * @return {!proto.watchclub.MoveScheduledPickResponse} returns this
 */
-proto.watchclub.JoinClubResponse.prototype.setClub = function(value) {
+proto.watchclub.MoveScheduledPickResponse.prototype.setAssignment = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.watchclub.JoinClubResponse} returns this
+ * @return {!proto.watchclub.MoveScheduledPickResponse} returns this
  */
-proto.watchclub.JoinClubResponse.prototype.clearClub = function() {
-  return this.setClub(undefined);
+proto.watchclub.MoveScheduledPickResponse.prototype.clearAssignment = function() {
+  return this.setAssignment(undefined);
 };
 
 
@@ -3050,7 +10083,7 @@ proto.watchclub.JoinClubResponse.prototype.clearClub = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.watchclub.JoinClubResponse.prototype.hasClub = function() {
+proto.watchclub.MoveScheduledPickResponse.prototype.hasAssignment = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -3071,8 +10104,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.AddPickRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.AddPickRequest.toObject(opt_includeInstance, this);
+proto.watchclub.DeleteScheduledPickRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteScheduledPickRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3081,18 +10114,13 @@ proto.watchclub.AddPickRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.AddPickRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.DeleteScheduledPickRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.DeleteScheduledPickRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    title: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    notes: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    link: jspb.Message.getFieldWithDefault(msg, 6, "")
+    scheduledPickId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3106,23 +10134,23 @@ proto.watchclub.AddPickRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.AddPickRequest}
+ * @return {!proto.watchclub.DeleteScheduledPickRequest}
  */
-proto.watchclub.AddPickRequest.deserializeBinary = function(bytes) {
+proto.watchclub.DeleteScheduledPickRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.AddPickRequest;
-  return proto.watchclub.AddPickRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.DeleteScheduledPickRequest;
+  return proto.watchclub.DeleteScheduledPickRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.AddPickRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.DeleteScheduledPickRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.AddPickRequest}
+ * @return {!proto.watchclub.DeleteScheduledPickRequest}
  */
-proto.watchclub.AddPickRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.DeleteScheduledPickRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3131,27 +10159,7 @@ proto.watchclub.AddPickRequest.deserializeBinaryFromReader = function(msg, reade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUserId(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTitle(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setYear(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNotes(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLink(value);
+      msg.setScheduledPickId(value);
       break;
     default:
       reader.skipField();
@@ -3166,9 +10174,9 @@ proto.watchclub.AddPickRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.AddPickRequest.prototype.serializeBinary = function() {
+proto.watchclub.DeleteScheduledPickRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.AddPickRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.DeleteScheduledPickRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3176,162 +10184,167 @@ proto.watchclub.AddPickRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.AddPickRequest} message
+ * @param {!proto.watchclub.DeleteScheduledPickRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.DeleteScheduledPickRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
+  f = message.getScheduledPickId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getUserId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getTitle();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getYear();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getNotes();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getLink();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string club_id = 1;
+ * optional string scheduled_pick_id = 1;
  * @return {string}
  */
-proto.watchclub.AddPickRequest.prototype.getClubId = function() {
+proto.watchclub.DeleteScheduledPickRequest.prototype.getScheduledPickId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.AddPickRequest} returns this
+ * @return {!proto.watchclub.DeleteScheduledPickRequest} returns this
  */
-proto.watchclub.AddPickRequest.prototype.setClubId = function(value) {
+proto.watchclub.DeleteScheduledPickRequest.prototype.setScheduledPickId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string user_id = 2;
- * @return {string}
- */
-proto.watchclub.AddPickRequest.prototype.getUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.watchclub.AddPickRequest} returns this
- */
-proto.watchclub.AddPickRequest.prototype.setUserId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string title = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.watchclub.AddPickRequest.prototype.getTitle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.watchclub.DeleteScheduledPickResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteScheduledPickResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.AddPickRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.watchclub.DeleteScheduledPickResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickRequest.prototype.setTitle = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.watchclub.DeleteScheduledPickResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int32 year = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.watchclub.DeleteScheduledPickResponse}
  */
-proto.watchclub.AddPickRequest.prototype.getYear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.watchclub.DeleteScheduledPickResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.watchclub.DeleteScheduledPickResponse;
+  return proto.watchclub.DeleteScheduledPickResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.watchclub.AddPickRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.watchclub.DeleteScheduledPickResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.watchclub.DeleteScheduledPickResponse}
  */
-proto.watchclub.AddPickRequest.prototype.setYear = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.watchclub.DeleteScheduledPickResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string notes = 5;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.watchclub.AddPickRequest.prototype.getNotes = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.watchclub.DeleteScheduledPickResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.watchclub.DeleteScheduledPickResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.AddPickRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.watchclub.DeleteScheduledPickResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickRequest.prototype.setNotes = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.watchclub.DeleteScheduledPickResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string link = 6;
- * @return {string}
+ * optional bool success = 1;
+ * @return {boolean}
  */
-proto.watchclub.AddPickRequest.prototype.getLink = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.watchclub.DeleteScheduledPickResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.AddPickRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.watchclub.DeleteScheduledPickResponse} returns this
  */
-proto.watchclub.AddPickRequest.prototype.setLink = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.watchclub.DeleteScheduledPickResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -3351,8 +10364,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.AddPickResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.AddPickResponse.toObject(opt_includeInstance, this);
+proto.watchclub.ResetClubRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ResetClubRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3361,13 +10374,13 @@ proto.watchclub.AddPickResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.AddPickResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.ResetClubRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.ResetClubRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pick: (f = msg.getPick()) && proto.watchclub.Pick.toObject(includeInstance, f)
+    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3381,23 +10394,23 @@ proto.watchclub.AddPickResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.AddPickResponse}
+ * @return {!proto.watchclub.ResetClubRequest}
  */
-proto.watchclub.AddPickResponse.deserializeBinary = function(bytes) {
+proto.watchclub.ResetClubRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.AddPickResponse;
-  return proto.watchclub.AddPickResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ResetClubRequest;
+  return proto.watchclub.ResetClubRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.AddPickResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ResetClubRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.AddPickResponse}
+ * @return {!proto.watchclub.ResetClubRequest}
  */
-proto.watchclub.AddPickResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ResetClubRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3405,9 +10418,8 @@ proto.watchclub.AddPickResponse.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Pick;
-      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
-      msg.setPick(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubId(value);
       break;
     default:
       reader.skipField();
@@ -3422,9 +10434,9 @@ proto.watchclub.AddPickResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.AddPickResponse.prototype.serializeBinary = function() {
+proto.watchclub.ResetClubRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.AddPickResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ResetClubRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3432,57 +10444,37 @@ proto.watchclub.AddPickResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.AddPickResponse} message
+ * @param {!proto.watchclub.ResetClubRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.AddPickResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ResetClubRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPick();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getClubId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.watchclub.Pick.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional Pick pick = 1;
- * @return {?proto.watchclub.Pick}
- */
-proto.watchclub.AddPickResponse.prototype.getPick = function() {
-  return /** @type{?proto.watchclub.Pick} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Pick, 1));
-};
-
-
-/**
- * @param {?proto.watchclub.Pick|undefined} value
- * @return {!proto.watchclub.AddPickResponse} returns this
-*/
-proto.watchclub.AddPickResponse.prototype.setPick = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.AddPickResponse} returns this
+ * optional string club_id = 1;
+ * @return {string}
  */
-proto.watchclub.AddPickResponse.prototype.clearPick = function() {
-  return this.setPick(undefined);
+proto.watchclub.ResetClubRequest.prototype.getClubId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.watchclub.ResetClubRequest} returns this
  */
-proto.watchclub.AddPickResponse.prototype.hasPick = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.watchclub.ResetClubRequest.prototype.setClubId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -3502,8 +10494,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.DeletePickRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.DeletePickRequest.toObject(opt_includeInstance, this);
+proto.watchclub.ResetClubResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ResetClubResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3512,14 +10504,13 @@ proto.watchclub.DeletePickRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.DeletePickRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.ResetClubResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeletePickRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.ResetClubResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pickId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    userId: jspb.Message.getFieldWithDefault(msg, 2, "")
+    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3533,23 +10524,23 @@ proto.watchclub.DeletePickRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.DeletePickRequest}
+ * @return {!proto.watchclub.ResetClubResponse}
  */
-proto.watchclub.DeletePickRequest.deserializeBinary = function(bytes) {
+proto.watchclub.ResetClubResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.DeletePickRequest;
-  return proto.watchclub.DeletePickRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ResetClubResponse;
+  return proto.watchclub.ResetClubResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.DeletePickRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ResetClubResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.DeletePickRequest}
+ * @return {!proto.watchclub.ResetClubResponse}
  */
-proto.watchclub.DeletePickRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ResetClubResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3557,12 +10548,9 @@ proto.watchclub.DeletePickRequest.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPickId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUserId(value);
+      var value = new proto.watchclub.Club;
+      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
+      msg.setClub(value);
       break;
     default:
       reader.skipField();
@@ -3577,9 +10565,9 @@ proto.watchclub.DeletePickRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.DeletePickRequest.prototype.serializeBinary = function() {
+proto.watchclub.ResetClubResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.DeletePickRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ResetClubResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3587,62 +10575,57 @@ proto.watchclub.DeletePickRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.DeletePickRequest} message
+ * @param {!proto.watchclub.ResetClubResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeletePickRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ResetClubResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPickId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getClub();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getUserId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.watchclub.Club.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string pick_id = 1;
- * @return {string}
+ * optional Club club = 1;
+ * @return {?proto.watchclub.Club}
  */
-proto.watchclub.DeletePickRequest.prototype.getPickId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.ResetClubResponse.prototype.getClub = function() {
+  return /** @type{?proto.watchclub.Club} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.DeletePickRequest} returns this
- */
-proto.watchclub.DeletePickRequest.prototype.setPickId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.watchclub.Club|undefined} value
+ * @return {!proto.watchclub.ResetClubResponse} returns this
+*/
+proto.watchclub.ResetClubResponse.prototype.setClub = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string user_id = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.ResetClubResponse} returns this
  */
-proto.watchclub.DeletePickRequest.prototype.getUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.watchclub.ResetClubResponse.prototype.clearClub = function() {
+  return this.setClub(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.DeletePickRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.DeletePickRequest.prototype.setUserId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.watchclub.ResetClubResponse.prototype.hasClub = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -3662,8 +10645,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.DeletePickResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.DeletePickResponse.toObject(opt_includeInstance, this);
+proto.watchclub.GetNotificationPreferencesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetNotificationPreferencesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3672,13 +10655,13 @@ proto.watchclub.DeletePickResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.DeletePickResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.GetNotificationPreferencesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeletePickResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.GetNotificationPreferencesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3692,23 +10675,23 @@ proto.watchclub.DeletePickResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.DeletePickResponse}
+ * @return {!proto.watchclub.GetNotificationPreferencesRequest}
  */
-proto.watchclub.DeletePickResponse.deserializeBinary = function(bytes) {
+proto.watchclub.GetNotificationPreferencesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.DeletePickResponse;
-  return proto.watchclub.DeletePickResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.GetNotificationPreferencesRequest;
+  return proto.watchclub.GetNotificationPreferencesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.DeletePickResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.GetNotificationPreferencesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.DeletePickResponse}
+ * @return {!proto.watchclub.GetNotificationPreferencesRequest}
  */
-proto.watchclub.DeletePickResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.GetNotificationPreferencesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3716,8 +10699,8 @@ proto.watchclub.DeletePickResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -3732,9 +10715,9 @@ proto.watchclub.DeletePickResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.DeletePickResponse.prototype.serializeBinary = function() {
+proto.watchclub.GetNotificationPreferencesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.DeletePickResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.GetNotificationPreferencesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3742,15 +10725,15 @@ proto.watchclub.DeletePickResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.DeletePickResponse} message
+ * @param {!proto.watchclub.GetNotificationPreferencesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeletePickResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.GetNotificationPreferencesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -3759,20 +10742,20 @@ proto.watchclub.DeletePickResponse.serializeBinaryToWriter = function(message, w
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string user_id = 1;
+ * @return {string}
  */
-proto.watchclub.DeletePickResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.watchclub.GetNotificationPreferencesRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.watchclub.DeletePickResponse} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.GetNotificationPreferencesRequest} returns this
  */
-proto.watchclub.DeletePickResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.watchclub.GetNotificationPreferencesRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -3792,8 +10775,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetClubRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetClubRequest.toObject(opt_includeInstance, this);
+proto.watchclub.GetNotificationPreferencesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetNotificationPreferencesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3802,13 +10785,13 @@ proto.watchclub.GetClubRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetClubRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.GetNotificationPreferencesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.GetNotificationPreferencesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    preferences: (f = msg.getPreferences()) && proto.watchclub.NotificationPreferences.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3822,23 +10805,23 @@ proto.watchclub.GetClubRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetClubRequest}
+ * @return {!proto.watchclub.GetNotificationPreferencesResponse}
  */
-proto.watchclub.GetClubRequest.deserializeBinary = function(bytes) {
+proto.watchclub.GetNotificationPreferencesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetClubRequest;
-  return proto.watchclub.GetClubRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.GetNotificationPreferencesResponse;
+  return proto.watchclub.GetNotificationPreferencesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetClubRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.GetNotificationPreferencesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetClubRequest}
+ * @return {!proto.watchclub.GetNotificationPreferencesResponse}
  */
-proto.watchclub.GetClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.GetNotificationPreferencesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3846,8 +10829,9 @@ proto.watchclub.GetClubRequest.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      var value = new proto.watchclub.NotificationPreferences;
+      reader.readMessage(value,proto.watchclub.NotificationPreferences.deserializeBinaryFromReader);
+      msg.setPreferences(value);
       break;
     default:
       reader.skipField();
@@ -3862,9 +10846,9 @@ proto.watchclub.GetClubRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetClubRequest.prototype.serializeBinary = function() {
+proto.watchclub.GetNotificationPreferencesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetClubRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.GetNotificationPreferencesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3872,47 +10856,60 @@ proto.watchclub.GetClubRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetClubRequest} message
+ * @param {!proto.watchclub.GetNotificationPreferencesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.GetNotificationPreferencesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPreferences();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.watchclub.NotificationPreferences.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string club_id = 1;
- * @return {string}
+ * optional NotificationPreferences preferences = 1;
+ * @return {?proto.watchclub.NotificationPreferences}
  */
-proto.watchclub.GetClubRequest.prototype.getClubId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.GetNotificationPreferencesResponse.prototype.getPreferences = function() {
+  return /** @type{?proto.watchclub.NotificationPreferences} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.NotificationPreferences, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.GetClubRequest} returns this
- */
-proto.watchclub.GetClubRequest.prototype.setClubId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.watchclub.NotificationPreferences|undefined} value
+ * @return {!proto.watchclub.GetNotificationPreferencesResponse} returns this
+*/
+proto.watchclub.GetNotificationPreferencesResponse.prototype.setPreferences = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.GetNotificationPreferencesResponse} returns this
+ */
+proto.watchclub.GetNotificationPreferencesResponse.prototype.clearPreferences = function() {
+  return this.setPreferences(undefined);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.GetClubResponse.repeatedFields_ = [2,3];
+proto.watchclub.GetNotificationPreferencesResponse.prototype.hasPreferences = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
 
 
 
@@ -3929,8 +10926,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetClubResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetClubResponse.toObject(opt_includeInstance, this);
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.UpdateNotificationPreferencesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3939,17 +10936,13 @@ proto.watchclub.GetClubResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetClubResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.UpdateNotificationPreferencesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f),
-    membersList: jspb.Message.toObjectList(msg.getMembersList(),
-    proto.watchclub.User.toObject, includeInstance),
-    picksList: jspb.Message.toObjectList(msg.getPicksList(),
-    proto.watchclub.Pick.toObject, includeInstance)
+    preferences: (f = msg.getPreferences()) && proto.watchclub.NotificationPreferences.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3963,23 +10956,23 @@ proto.watchclub.GetClubResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetClubResponse}
+ * @return {!proto.watchclub.UpdateNotificationPreferencesRequest}
  */
-proto.watchclub.GetClubResponse.deserializeBinary = function(bytes) {
+proto.watchclub.UpdateNotificationPreferencesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetClubResponse;
-  return proto.watchclub.GetClubResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.UpdateNotificationPreferencesRequest;
+  return proto.watchclub.UpdateNotificationPreferencesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetClubResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetClubResponse}
+ * @return {!proto.watchclub.UpdateNotificationPreferencesRequest}
  */
-proto.watchclub.GetClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.UpdateNotificationPreferencesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3987,19 +10980,9 @@ proto.watchclub.GetClubResponse.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Club;
-      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
-      msg.setClub(value);
-      break;
-    case 2:
-      var value = new proto.watchclub.User;
-      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
-      msg.addMembers(value);
-      break;
-    case 3:
-      var value = new proto.watchclub.Pick;
-      reader.readMessage(value,proto.watchclub.Pick.deserializeBinaryFromReader);
-      msg.addPicks(value);
+      var value = new proto.watchclub.NotificationPreferences;
+      reader.readMessage(value,proto.watchclub.NotificationPreferences.deserializeBinaryFromReader);
+      msg.setPreferences(value);
       break;
     default:
       reader.skipField();
@@ -4014,9 +10997,9 @@ proto.watchclub.GetClubResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetClubResponse.prototype.serializeBinary = function() {
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetClubResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.UpdateNotificationPreferencesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4024,64 +11007,48 @@ proto.watchclub.GetClubResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetClubResponse} message
+ * @param {!proto.watchclub.UpdateNotificationPreferencesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.UpdateNotificationPreferencesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClub();
+  f = message.getPreferences();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.watchclub.Club.serializeBinaryToWriter
-    );
-  }
-  f = message.getMembersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.watchclub.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getPicksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.watchclub.Pick.serializeBinaryToWriter
+      proto.watchclub.NotificationPreferences.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Club club = 1;
- * @return {?proto.watchclub.Club}
+ * optional NotificationPreferences preferences = 1;
+ * @return {?proto.watchclub.NotificationPreferences}
  */
-proto.watchclub.GetClubResponse.prototype.getClub = function() {
-  return /** @type{?proto.watchclub.Club} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.getPreferences = function() {
+  return /** @type{?proto.watchclub.NotificationPreferences} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.NotificationPreferences, 1));
 };
 
 
 /**
- * @param {?proto.watchclub.Club|undefined} value
- * @return {!proto.watchclub.GetClubResponse} returns this
+ * @param {?proto.watchclub.NotificationPreferences|undefined} value
+ * @return {!proto.watchclub.UpdateNotificationPreferencesRequest} returns this
 */
-proto.watchclub.GetClubResponse.prototype.setClub = function(value) {
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.setPreferences = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.watchclub.GetClubResponse} returns this
+ * @return {!proto.watchclub.UpdateNotificationPreferencesRequest} returns this
  */
-proto.watchclub.GetClubResponse.prototype.clearClub = function() {
-  return this.setClub(undefined);
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.clearPreferences = function() {
+  return this.setPreferences(undefined);
 };
 
 
@@ -4089,87 +11056,11 @@ proto.watchclub.GetClubResponse.prototype.clearClub = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.watchclub.GetClubResponse.prototype.hasClub = function() {
+proto.watchclub.UpdateNotificationPreferencesRequest.prototype.hasPreferences = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * repeated User members = 2;
- * @return {!Array<!proto.watchclub.User>}
- */
-proto.watchclub.GetClubResponse.prototype.getMembersList = function() {
-  return /** @type{!Array<!proto.watchclub.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.User, 2));
-};
-
-
-/**
- * @param {!Array<!proto.watchclub.User>} value
- * @return {!proto.watchclub.GetClubResponse} returns this
-*/
-proto.watchclub.GetClubResponse.prototype.setMembersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
-
-
-/**
- * @param {!proto.watchclub.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.watchclub.User}
- */
-proto.watchclub.GetClubResponse.prototype.addMembers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.watchclub.User, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.GetClubResponse} returns this
- */
-proto.watchclub.GetClubResponse.prototype.clearMembersList = function() {
-  return this.setMembersList([]);
-};
-
-
-/**
- * repeated Pick picks = 3;
- * @return {!Array<!proto.watchclub.Pick>}
- */
-proto.watchclub.GetClubResponse.prototype.getPicksList = function() {
-  return /** @type{!Array<!proto.watchclub.Pick>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Pick, 3));
-};
-
-
-/**
- * @param {!Array<!proto.watchclub.Pick>} value
- * @return {!proto.watchclub.GetClubResponse} returns this
-*/
-proto.watchclub.GetClubResponse.prototype.setPicksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
-};
-
-
-/**
- * @param {!proto.watchclub.Pick=} opt_value
- * @param {number=} opt_index
- * @return {!proto.watchclub.Pick}
- */
-proto.watchclub.GetClubResponse.prototype.addPicks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.watchclub.Pick, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.GetClubResponse} returns this
- */
-proto.watchclub.GetClubResponse.prototype.clearPicksList = function() {
-  return this.setPicksList([]);
-};
-
-
 
 
 
@@ -4186,8 +11077,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.StartClubRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.StartClubRequest.toObject(opt_includeInstance, this);
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.UpdateNotificationPreferencesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4196,13 +11087,13 @@ proto.watchclub.StartClubRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.StartClubRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.UpdateNotificationPreferencesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.StartClubRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.UpdateNotificationPreferencesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    preferences: (f = msg.getPreferences()) && proto.watchclub.NotificationPreferences.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4216,23 +11107,23 @@ proto.watchclub.StartClubRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.StartClubRequest}
+ * @return {!proto.watchclub.UpdateNotificationPreferencesResponse}
  */
-proto.watchclub.StartClubRequest.deserializeBinary = function(bytes) {
+proto.watchclub.UpdateNotificationPreferencesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.StartClubRequest;
-  return proto.watchclub.StartClubRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.UpdateNotificationPreferencesResponse;
+  return proto.watchclub.UpdateNotificationPreferencesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.StartClubRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.UpdateNotificationPreferencesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.StartClubRequest}
+ * @return {!proto.watchclub.UpdateNotificationPreferencesResponse}
  */
-proto.watchclub.StartClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.UpdateNotificationPreferencesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4240,8 +11131,9 @@ proto.watchclub.StartClubRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      var value = new proto.watchclub.NotificationPreferences;
+      reader.readMessage(value,proto.watchclub.NotificationPreferences.deserializeBinaryFromReader);
+      msg.setPreferences(value);
       break;
     default:
       reader.skipField();
@@ -4256,9 +11148,9 @@ proto.watchclub.StartClubRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.StartClubRequest.prototype.serializeBinary = function() {
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.StartClubRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.UpdateNotificationPreferencesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4266,47 +11158,60 @@ proto.watchclub.StartClubRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.StartClubRequest} message
+ * @param {!proto.watchclub.UpdateNotificationPreferencesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.StartClubRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.UpdateNotificationPreferencesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPreferences();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.watchclub.NotificationPreferences.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string club_id = 1;
- * @return {string}
+ * optional NotificationPreferences preferences = 1;
+ * @return {?proto.watchclub.NotificationPreferences}
  */
-proto.watchclub.StartClubRequest.prototype.getClubId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.getPreferences = function() {
+  return /** @type{?proto.watchclub.NotificationPreferences} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.NotificationPreferences, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.StartClubRequest} returns this
- */
-proto.watchclub.StartClubRequest.prototype.setClubId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.watchclub.NotificationPreferences|undefined} value
+ * @return {!proto.watchclub.UpdateNotificationPreferencesResponse} returns this
+*/
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.setPreferences = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.UpdateNotificationPreferencesResponse} returns this
+ */
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.clearPreferences = function() {
+  return this.setPreferences(undefined);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.StartClubResponse.repeatedFields_ = [2];
+proto.watchclub.UpdateNotificationPreferencesResponse.prototype.hasPreferences = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
 
 
 
@@ -4323,8 +11228,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.StartClubResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.StartClubResponse.toObject(opt_includeInstance, this);
+proto.watchclub.CalendarFeed.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CalendarFeed.toObject(opt_includeInstance, this);
 };
 
 
@@ -4333,15 +11238,15 @@ proto.watchclub.StartClubResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.StartClubResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.CalendarFeed} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.StartClubResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.CalendarFeed.toObject = function(includeInstance, msg) {
   var f, obj = {
-    club: (f = msg.getClub()) && proto.watchclub.Club.toObject(includeInstance, f),
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.watchclub.ScheduledPick.toObject, includeInstance)
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4355,23 +11260,23 @@ proto.watchclub.StartClubResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.StartClubResponse}
+ * @return {!proto.watchclub.CalendarFeed}
  */
-proto.watchclub.StartClubResponse.deserializeBinary = function(bytes) {
+proto.watchclub.CalendarFeed.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.StartClubResponse;
-  return proto.watchclub.StartClubResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.CalendarFeed;
+  return proto.watchclub.CalendarFeed.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.StartClubResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.CalendarFeed} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.StartClubResponse}
+ * @return {!proto.watchclub.CalendarFeed}
  */
-proto.watchclub.StartClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.CalendarFeed.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4379,14 +11284,17 @@ proto.watchclub.StartClubResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Club;
-      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
-      msg.setClub(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserId(value);
       break;
     case 2:
-      var value = new proto.watchclub.ScheduledPick;
-      reader.readMessage(value,proto.watchclub.ScheduledPick.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
       break;
     default:
       reader.skipField();
@@ -4401,9 +11309,9 @@ proto.watchclub.StartClubResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.StartClubResponse.prototype.serializeBinary = function() {
+proto.watchclub.CalendarFeed.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.StartClubResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.CalendarFeed.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4411,103 +11319,107 @@ proto.watchclub.StartClubResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.StartClubResponse} message
+ * @param {!proto.watchclub.CalendarFeed} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.StartClubResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.CalendarFeed.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClub();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.watchclub.Club.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getAssignmentsList();
+  f = message.getToken();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
       f,
-      proto.watchclub.ScheduledPick.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Club club = 1;
- * @return {?proto.watchclub.Club}
+ * optional string user_id = 1;
+ * @return {string}
  */
-proto.watchclub.StartClubResponse.prototype.getClub = function() {
-  return /** @type{?proto.watchclub.Club} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.Club, 1));
+proto.watchclub.CalendarFeed.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.watchclub.Club|undefined} value
- * @return {!proto.watchclub.StartClubResponse} returns this
-*/
-proto.watchclub.StartClubResponse.prototype.setClub = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.watchclub.CalendarFeed} returns this
+ */
+proto.watchclub.CalendarFeed.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.watchclub.StartClubResponse} returns this
+ * optional string token = 2;
+ * @return {string}
  */
-proto.watchclub.StartClubResponse.prototype.clearClub = function() {
-  return this.setClub(undefined);
+proto.watchclub.CalendarFeed.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.watchclub.StartClubResponse.prototype.hasClub = function() {
-  return jspb.Message.getField(this, 1) != null;
+ * @param {string} value
+ * @return {!proto.watchclub.CalendarFeed} returns this
+ */
+proto.watchclub.CalendarFeed.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated ScheduledPick assignments = 2;
- * @return {!Array<!proto.watchclub.ScheduledPick>}
+ * optional google.protobuf.Timestamp created_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.watchclub.StartClubResponse.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.watchclub.ScheduledPick>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.ScheduledPick, 2));
+proto.watchclub.CalendarFeed.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * @param {!Array<!proto.watchclub.ScheduledPick>} value
- * @return {!proto.watchclub.StartClubResponse} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.CalendarFeed} returns this
 */
-proto.watchclub.StartClubResponse.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.watchclub.CalendarFeed.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.watchclub.ScheduledPick=} opt_value
- * @param {number=} opt_index
- * @return {!proto.watchclub.ScheduledPick}
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.CalendarFeed} returns this
  */
-proto.watchclub.StartClubResponse.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.watchclub.ScheduledPick, opt_index);
+proto.watchclub.CalendarFeed.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.StartClubResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.StartClubResponse.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.watchclub.CalendarFeed.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -4527,8 +11439,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetScheduledPicksRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetScheduledPicksRequest.toObject(opt_includeInstance, this);
+proto.watchclub.GetCalendarFeedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetCalendarFeedRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4537,13 +11449,13 @@ proto.watchclub.GetScheduledPicksRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetScheduledPicksRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.GetCalendarFeedRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetScheduledPicksRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.GetCalendarFeedRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4557,23 +11469,23 @@ proto.watchclub.GetScheduledPicksRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetScheduledPicksRequest}
+ * @return {!proto.watchclub.GetCalendarFeedRequest}
  */
-proto.watchclub.GetScheduledPicksRequest.deserializeBinary = function(bytes) {
+proto.watchclub.GetCalendarFeedRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetScheduledPicksRequest;
-  return proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.GetCalendarFeedRequest;
+  return proto.watchclub.GetCalendarFeedRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetScheduledPicksRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.GetCalendarFeedRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetScheduledPicksRequest}
+ * @return {!proto.watchclub.GetCalendarFeedRequest}
  */
-proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.GetCalendarFeedRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4582,7 +11494,7 @@ proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -4597,9 +11509,9 @@ proto.watchclub.GetScheduledPicksRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetScheduledPicksRequest.prototype.serializeBinary = function() {
+proto.watchclub.GetCalendarFeedRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetScheduledPicksRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.GetCalendarFeedRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4607,13 +11519,13 @@ proto.watchclub.GetScheduledPicksRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetScheduledPicksRequest} message
+ * @param {!proto.watchclub.GetCalendarFeedRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetScheduledPicksRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.GetCalendarFeedRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -4624,31 +11536,24 @@ proto.watchclub.GetScheduledPicksRequest.serializeBinaryToWriter = function(mess
 
 
 /**
- * optional string club_id = 1;
+ * optional string user_id = 1;
  * @return {string}
  */
-proto.watchclub.GetScheduledPicksRequest.prototype.getClubId = function() {
+proto.watchclub.GetCalendarFeedRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.GetScheduledPicksRequest} returns this
+ * @return {!proto.watchclub.GetCalendarFeedRequest} returns this
  */
-proto.watchclub.GetScheduledPicksRequest.prototype.setClubId = function(value) {
+proto.watchclub.GetCalendarFeedRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.watchclub.GetScheduledPicksResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4664,8 +11569,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetScheduledPicksResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetScheduledPicksResponse.toObject(opt_includeInstance, this);
+proto.watchclub.GetCalendarFeedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.GetCalendarFeedResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4674,14 +11579,16 @@ proto.watchclub.GetScheduledPicksResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetScheduledPicksResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.GetCalendarFeedResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetScheduledPicksResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.GetCalendarFeedResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.watchclub.ScheduledPick.toObject, includeInstance)
+    token: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    webcalUrl: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    clubUrlTemplate: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -4695,23 +11602,23 @@ proto.watchclub.GetScheduledPicksResponse.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetScheduledPicksResponse}
+ * @return {!proto.watchclub.GetCalendarFeedResponse}
  */
-proto.watchclub.GetScheduledPicksResponse.deserializeBinary = function(bytes) {
+proto.watchclub.GetCalendarFeedResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetScheduledPicksResponse;
-  return proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.GetCalendarFeedResponse;
+  return proto.watchclub.GetCalendarFeedResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetScheduledPicksResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.GetCalendarFeedResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetScheduledPicksResponse}
+ * @return {!proto.watchclub.GetCalendarFeedResponse}
  */
-proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.GetCalendarFeedResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4719,9 +11626,20 @@ proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.ScheduledPick;
-      reader.readMessage(value,proto.watchclub.ScheduledPick.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWebcalUrl(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClubUrlTemplate(value);
       break;
     default:
       reader.skipField();
@@ -4736,9 +11654,9 @@ proto.watchclub.GetScheduledPicksResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetScheduledPicksResponse.prototype.serializeBinary = function() {
+proto.watchclub.GetCalendarFeedResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetScheduledPicksResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.GetCalendarFeedResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4746,58 +11664,112 @@ proto.watchclub.GetScheduledPicksResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetScheduledPicksResponse} message
+ * @param {!proto.watchclub.GetCalendarFeedResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetScheduledPicksResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.GetCalendarFeedResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignmentsList();
+  f = message.getToken();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.watchclub.ScheduledPick.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getWebcalUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getClubUrlTemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated ScheduledPick assignments = 1;
- * @return {!Array<!proto.watchclub.ScheduledPick>}
+ * optional string token = 1;
+ * @return {string}
  */
-proto.watchclub.GetScheduledPicksResponse.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.watchclub.ScheduledPick>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.ScheduledPick, 1));
+proto.watchclub.GetCalendarFeedResponse.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.watchclub.ScheduledPick>} value
- * @return {!proto.watchclub.GetScheduledPicksResponse} returns this
-*/
-proto.watchclub.GetScheduledPicksResponse.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.watchclub.GetCalendarFeedResponse} returns this
+ */
+proto.watchclub.GetCalendarFeedResponse.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.watchclub.ScheduledPick=} opt_value
- * @param {number=} opt_index
- * @return {!proto.watchclub.ScheduledPick}
+ * optional string url = 2;
+ * @return {string}
  */
-proto.watchclub.GetScheduledPicksResponse.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.ScheduledPick, opt_index);
+proto.watchclub.GetCalendarFeedResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.GetScheduledPicksResponse} returns this
+ * @param {string} value
+ * @return {!proto.watchclub.GetCalendarFeedResponse} returns this
  */
-proto.watchclub.GetScheduledPicksResponse.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.watchclub.GetCalendarFeedResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string webcal_url = 3;
+ * @return {string}
+ */
+proto.watchclub.GetCalendarFeedResponse.prototype.getWebcalUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetCalendarFeedResponse} returns this
+ */
+proto.watchclub.GetCalendarFeedResponse.prototype.setWebcalUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string club_url_template = 4;
+ * @return {string}
+ */
+proto.watchclub.GetCalendarFeedResponse.prototype.getClubUrlTemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.GetCalendarFeedResponse} returns this
+ */
+proto.watchclub.GetCalendarFeedResponse.prototype.setClubUrlTemplate = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -4817,8 +11789,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.SendLoginEmailRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.SendLoginEmailRequest.toObject(opt_includeInstance, this);
+proto.watchclub.RotateCalendarFeedTokenRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RotateCalendarFeedTokenRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4827,13 +11799,13 @@ proto.watchclub.SendLoginEmailRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.SendLoginEmailRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.SendLoginEmailRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.RotateCalendarFeedTokenRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    email: jspb.Message.getFieldWithDefault(msg, 1, "")
+    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4847,23 +11819,23 @@ proto.watchclub.SendLoginEmailRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.SendLoginEmailRequest}
+ * @return {!proto.watchclub.RotateCalendarFeedTokenRequest}
  */
-proto.watchclub.SendLoginEmailRequest.deserializeBinary = function(bytes) {
+proto.watchclub.RotateCalendarFeedTokenRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.SendLoginEmailRequest;
-  return proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.RotateCalendarFeedTokenRequest;
+  return proto.watchclub.RotateCalendarFeedTokenRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.SendLoginEmailRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.SendLoginEmailRequest}
+ * @return {!proto.watchclub.RotateCalendarFeedTokenRequest}
  */
-proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.RotateCalendarFeedTokenRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4872,7 +11844,7 @@ proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader = function(msg
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      msg.setUserId(value);
       break;
     default:
       reader.skipField();
@@ -4887,9 +11859,9 @@ proto.watchclub.SendLoginEmailRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.SendLoginEmailRequest.prototype.serializeBinary = function() {
+proto.watchclub.RotateCalendarFeedTokenRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.SendLoginEmailRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.RotateCalendarFeedTokenRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4897,13 +11869,13 @@ proto.watchclub.SendLoginEmailRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.SendLoginEmailRequest} message
+ * @param {!proto.watchclub.RotateCalendarFeedTokenRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.SendLoginEmailRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.RotateCalendarFeedTokenRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEmail();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -4914,19 +11886,19 @@ proto.watchclub.SendLoginEmailRequest.serializeBinaryToWriter = function(message
 
 
 /**
- * optional string email = 1;
+ * optional string user_id = 1;
  * @return {string}
  */
-proto.watchclub.SendLoginEmailRequest.prototype.getEmail = function() {
+proto.watchclub.RotateCalendarFeedTokenRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.SendLoginEmailRequest} returns this
+ * @return {!proto.watchclub.RotateCalendarFeedTokenRequest} returns this
  */
-proto.watchclub.SendLoginEmailRequest.prototype.setEmail = function(value) {
+proto.watchclub.RotateCalendarFeedTokenRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -4947,8 +11919,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.SendLoginEmailResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.SendLoginEmailResponse.toObject(opt_includeInstance, this);
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.RotateCalendarFeedTokenResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4957,14 +11929,13 @@ proto.watchclub.SendLoginEmailResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.SendLoginEmailResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.RotateCalendarFeedTokenResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.SendLoginEmailResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.RotateCalendarFeedTokenResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    message: jspb.Message.getFieldWithDefault(msg, 2, "")
+    feed: (f = msg.getFeed()) && proto.watchclub.GetCalendarFeedResponse.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4978,23 +11949,23 @@ proto.watchclub.SendLoginEmailResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.SendLoginEmailResponse}
+ * @return {!proto.watchclub.RotateCalendarFeedTokenResponse}
  */
-proto.watchclub.SendLoginEmailResponse.deserializeBinary = function(bytes) {
+proto.watchclub.RotateCalendarFeedTokenResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.SendLoginEmailResponse;
-  return proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.RotateCalendarFeedTokenResponse;
+  return proto.watchclub.RotateCalendarFeedTokenResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.SendLoginEmailResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.RotateCalendarFeedTokenResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.SendLoginEmailResponse}
+ * @return {!proto.watchclub.RotateCalendarFeedTokenResponse}
  */
-proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.RotateCalendarFeedTokenResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5002,12 +11973,9 @@ proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
+      var value = new proto.watchclub.GetCalendarFeedResponse;
+      reader.readMessage(value,proto.watchclub.GetCalendarFeedResponse.deserializeBinaryFromReader);
+      msg.setFeed(value);
       break;
     default:
       reader.skipField();
@@ -5022,9 +11990,9 @@ proto.watchclub.SendLoginEmailResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.SendLoginEmailResponse.prototype.serializeBinary = function() {
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.SendLoginEmailResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.RotateCalendarFeedTokenResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5032,62 +12000,57 @@ proto.watchclub.SendLoginEmailResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.SendLoginEmailResponse} message
+ * @param {!proto.watchclub.RotateCalendarFeedTokenResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.SendLoginEmailResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.RotateCalendarFeedTokenResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getFeed();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.watchclub.GetCalendarFeedResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional GetCalendarFeedResponse feed = 1;
+ * @return {?proto.watchclub.GetCalendarFeedResponse}
  */
-proto.watchclub.SendLoginEmailResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.getFeed = function() {
+  return /** @type{?proto.watchclub.GetCalendarFeedResponse} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.GetCalendarFeedResponse, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.watchclub.SendLoginEmailResponse} returns this
- */
-proto.watchclub.SendLoginEmailResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+ * @param {?proto.watchclub.GetCalendarFeedResponse|undefined} value
+ * @return {!proto.watchclub.RotateCalendarFeedTokenResponse} returns this
+*/
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.setFeed = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string message = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.RotateCalendarFeedTokenResponse} returns this
  */
-proto.watchclub.SendLoginEmailResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.clearFeed = function() {
+  return this.setFeed(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.watchclub.SendLoginEmailResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.SendLoginEmailResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.watchclub.RotateCalendarFeedTokenResponse.prototype.hasFeed = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -5107,8 +12070,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetUserRequest.toObject(opt_includeInstance, this);
+proto.watchclub.AppPassword.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.AppPassword.toObject(opt_includeInstance, this);
 };
 
 
@@ -5117,13 +12080,18 @@ proto.watchclub.GetUserRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetUserRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.AppPassword} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetUserRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.AppPassword.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    userId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    passwordHash: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastUsedAt: (f = msg.getLastUsedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5137,23 +12105,23 @@ proto.watchclub.GetUserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetUserRequest}
+ * @return {!proto.watchclub.AppPassword}
  */
-proto.watchclub.GetUserRequest.deserializeBinary = function(bytes) {
+proto.watchclub.AppPassword.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetUserRequest;
-  return proto.watchclub.GetUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.AppPassword;
+  return proto.watchclub.AppPassword.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetUserRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.AppPassword} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetUserRequest}
+ * @return {!proto.watchclub.AppPassword}
  */
-proto.watchclub.GetUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.AppPassword.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5161,9 +12129,31 @@ proto.watchclub.GetUserRequest.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setUserId(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPasswordHash(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastUsedAt(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5177,9 +12167,9 @@ proto.watchclub.GetUserRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetUserRequest.prototype.serializeBinary = function() {
+proto.watchclub.AppPassword.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetUserRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.AppPassword.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5187,179 +12177,193 @@ proto.watchclub.GetUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetUserRequest} message
+ * @param {!proto.watchclub.AppPassword} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.AppPassword.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getUserId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPasswordHash();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastUsedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string user_id = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.watchclub.GetUserRequest.prototype.getUserId = function() {
+proto.watchclub.AppPassword.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.GetUserRequest} returns this
+ * @return {!proto.watchclub.AppPassword} returns this
  */
-proto.watchclub.GetUserRequest.prototype.setUserId = function(value) {
+proto.watchclub.AppPassword.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string user_id = 2;
+ * @return {string}
+ */
+proto.watchclub.AppPassword.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
 
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.AppPassword} returns this
+ */
+proto.watchclub.AppPassword.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string name = 3;
+ * @return {string}
  */
-proto.watchclub.GetUserResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetUserResponse.toObject(opt_includeInstance, this);
+proto.watchclub.AppPassword.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetUserResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.watchclub.AppPassword} returns this
  */
-proto.watchclub.GetUserResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    user: (f = msg.getUser()) && proto.watchclub.User.toObject(includeInstance, f)
-  };
+proto.watchclub.AppPassword.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional string password_hash = 4;
+ * @return {string}
+ */
+proto.watchclub.AppPassword.prototype.getPasswordHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetUserResponse}
+ * @param {string} value
+ * @return {!proto.watchclub.AppPassword} returns this
  */
-proto.watchclub.GetUserResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetUserResponse;
-  return proto.watchclub.GetUserResponse.deserializeBinaryFromReader(msg, reader);
+proto.watchclub.AppPassword.prototype.setPasswordHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.watchclub.GetUserResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetUserResponse}
+ * optional google.protobuf.Timestamp created_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.watchclub.GetUserResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.watchclub.User;
-      reader.readMessage(value,proto.watchclub.User.deserializeBinaryFromReader);
-      msg.setUser(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.watchclub.AppPassword.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.AppPassword} returns this
+*/
+proto.watchclub.AppPassword.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.AppPassword} returns this
  */
-proto.watchclub.GetUserResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetUserResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.watchclub.AppPassword.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetUserResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.watchclub.GetUserResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.watchclub.User.serializeBinaryToWriter
-    );
-  }
+proto.watchclub.AppPassword.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional User user = 1;
- * @return {?proto.watchclub.User}
+ * optional google.protobuf.Timestamp last_used_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.watchclub.GetUserResponse.prototype.getUser = function() {
-  return /** @type{?proto.watchclub.User} */ (
-    jspb.Message.getWrapperField(this, proto.watchclub.User, 1));
+proto.watchclub.AppPassword.prototype.getLastUsedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
 };
 
 
 /**
- * @param {?proto.watchclub.User|undefined} value
- * @return {!proto.watchclub.GetUserResponse} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.watchclub.AppPassword} returns this
 */
-proto.watchclub.GetUserResponse.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.watchclub.AppPassword.prototype.setLastUsedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.watchclub.GetUserResponse} returns this
+ * @return {!proto.watchclub.AppPassword} returns this
  */
-proto.watchclub.GetUserResponse.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.watchclub.AppPassword.prototype.clearLastUsedAt = function() {
+  return this.setLastUsedAt(undefined);
 };
 
 
@@ -5367,8 +12371,8 @@ proto.watchclub.GetUserResponse.prototype.clearUser = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.watchclub.GetUserResponse.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.watchclub.AppPassword.prototype.hasLastUsedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
@@ -5388,8 +12392,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetClubCalendarRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetClubCalendarRequest.toObject(opt_includeInstance, this);
+proto.watchclub.CreateAppPasswordRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateAppPasswordRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5398,13 +12402,14 @@ proto.watchclub.GetClubCalendarRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetClubCalendarRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.CreateAppPasswordRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubCalendarRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.CreateAppPasswordRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5418,23 +12423,23 @@ proto.watchclub.GetClubCalendarRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetClubCalendarRequest}
+ * @return {!proto.watchclub.CreateAppPasswordRequest}
  */
-proto.watchclub.GetClubCalendarRequest.deserializeBinary = function(bytes) {
+proto.watchclub.CreateAppPasswordRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetClubCalendarRequest;
-  return proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.CreateAppPasswordRequest;
+  return proto.watchclub.CreateAppPasswordRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetClubCalendarRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.CreateAppPasswordRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetClubCalendarRequest}
+ * @return {!proto.watchclub.CreateAppPasswordRequest}
  */
-proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.CreateAppPasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5443,7 +12448,11 @@ proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader = function(ms
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5458,9 +12467,9 @@ proto.watchclub.GetClubCalendarRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetClubCalendarRequest.prototype.serializeBinary = function() {
+proto.watchclub.CreateAppPasswordRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetClubCalendarRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.CreateAppPasswordRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5468,37 +12477,62 @@ proto.watchclub.GetClubCalendarRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetClubCalendarRequest} message
+ * @param {!proto.watchclub.CreateAppPasswordRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubCalendarRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.CreateAppPasswordRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string user_id = 1;
+ * @return {string}
+ */
+proto.watchclub.CreateAppPasswordRequest.prototype.getUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional string club_id = 1;
+ * @param {string} value
+ * @return {!proto.watchclub.CreateAppPasswordRequest} returns this
+ */
+proto.watchclub.CreateAppPasswordRequest.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
  * @return {string}
  */
-proto.watchclub.GetClubCalendarRequest.prototype.getClubId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.CreateAppPasswordRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.GetClubCalendarRequest} returns this
+ * @return {!proto.watchclub.CreateAppPasswordRequest} returns this
  */
-proto.watchclub.GetClubCalendarRequest.prototype.setClubId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.watchclub.CreateAppPasswordRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -5518,8 +12552,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.GetClubCalendarResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.GetClubCalendarResponse.toObject(opt_includeInstance, this);
+proto.watchclub.CreateAppPasswordResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.CreateAppPasswordResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5528,13 +12562,16 @@ proto.watchclub.GetClubCalendarResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.GetClubCalendarResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.CreateAppPasswordResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubCalendarResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.CreateAppPasswordResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    icsData: jspb.Message.getFieldWithDefault(msg, 1, "")
+    appPassword: (f = msg.getAppPassword()) && proto.watchclub.AppPassword.toObject(includeInstance, f),
+    password: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    username: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    caldavUrl: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5548,23 +12585,23 @@ proto.watchclub.GetClubCalendarResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.GetClubCalendarResponse}
+ * @return {!proto.watchclub.CreateAppPasswordResponse}
  */
-proto.watchclub.GetClubCalendarResponse.deserializeBinary = function(bytes) {
+proto.watchclub.CreateAppPasswordResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.GetClubCalendarResponse;
-  return proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.CreateAppPasswordResponse;
+  return proto.watchclub.CreateAppPasswordResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.GetClubCalendarResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.CreateAppPasswordResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.GetClubCalendarResponse}
+ * @return {!proto.watchclub.CreateAppPasswordResponse}
  */
-proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.CreateAppPasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5572,8 +12609,21 @@ proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = new proto.watchclub.AppPassword;
+      reader.readMessage(value,proto.watchclub.AppPassword.deserializeBinaryFromReader);
+      msg.setAppPassword(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIcsData(value);
+      msg.setPassword(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCaldavUrl(value);
       break;
     default:
       reader.skipField();
@@ -5588,9 +12638,9 @@ proto.watchclub.GetClubCalendarResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.GetClubCalendarResponse.prototype.serializeBinary = function() {
+proto.watchclub.CreateAppPasswordResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.GetClubCalendarResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.CreateAppPasswordResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5598,16 +12648,38 @@ proto.watchclub.GetClubCalendarResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.GetClubCalendarResponse} message
+ * @param {!proto.watchclub.CreateAppPasswordResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.GetClubCalendarResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.CreateAppPasswordResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIcsData();
+  f = message.getAppPassword();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.watchclub.AppPassword.serializeBinaryToWriter
+    );
+  }
+  f = message.getPassword();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      2,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCaldavUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
       f
     );
   }
@@ -5615,20 +12687,93 @@ proto.watchclub.GetClubCalendarResponse.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional string ics_data = 1;
+ * optional AppPassword app_password = 1;
+ * @return {?proto.watchclub.AppPassword}
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.getAppPassword = function() {
+  return /** @type{?proto.watchclub.AppPassword} */ (
+    jspb.Message.getWrapperField(this, proto.watchclub.AppPassword, 1));
+};
+
+
+/**
+ * @param {?proto.watchclub.AppPassword|undefined} value
+ * @return {!proto.watchclub.CreateAppPasswordResponse} returns this
+*/
+proto.watchclub.CreateAppPasswordResponse.prototype.setAppPassword = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.watchclub.CreateAppPasswordResponse} returns this
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.clearAppPassword = function() {
+  return this.setAppPassword(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.hasAppPassword = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string password = 2;
  * @return {string}
  */
-proto.watchclub.GetClubCalendarResponse.prototype.getIcsData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.watchclub.CreateAppPasswordResponse.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.GetClubCalendarResponse} returns this
+ * @return {!proto.watchclub.CreateAppPasswordResponse} returns this
  */
-proto.watchclub.GetClubCalendarResponse.prototype.setIcsData = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.watchclub.CreateAppPasswordResponse.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string username = 3;
+ * @return {string}
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.CreateAppPasswordResponse} returns this
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string caldav_url = 4;
+ * @return {string}
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.getCaldavUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.CreateAppPasswordResponse} returns this
+ */
+proto.watchclub.CreateAppPasswordResponse.prototype.setCaldavUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -5648,8 +12793,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.ListUserClubsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.ListUserClubsRequest.toObject(opt_includeInstance, this);
+proto.watchclub.ListAppPasswordsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListAppPasswordsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5658,11 +12803,11 @@ proto.watchclub.ListUserClubsRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.ListUserClubsRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.ListAppPasswordsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ListUserClubsRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.ListAppPasswordsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     userId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -5678,23 +12823,23 @@ proto.watchclub.ListUserClubsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.ListUserClubsRequest}
+ * @return {!proto.watchclub.ListAppPasswordsRequest}
  */
-proto.watchclub.ListUserClubsRequest.deserializeBinary = function(bytes) {
+proto.watchclub.ListAppPasswordsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.ListUserClubsRequest;
-  return proto.watchclub.ListUserClubsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ListAppPasswordsRequest;
+  return proto.watchclub.ListAppPasswordsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.ListUserClubsRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ListAppPasswordsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.ListUserClubsRequest}
+ * @return {!proto.watchclub.ListAppPasswordsRequest}
  */
-proto.watchclub.ListUserClubsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ListAppPasswordsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5718,9 +12863,9 @@ proto.watchclub.ListUserClubsRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.ListUserClubsRequest.prototype.serializeBinary = function() {
+proto.watchclub.ListAppPasswordsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.ListUserClubsRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ListAppPasswordsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5728,11 +12873,11 @@ proto.watchclub.ListUserClubsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.ListUserClubsRequest} message
+ * @param {!proto.watchclub.ListAppPasswordsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ListUserClubsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListAppPasswordsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUserId();
   if (f.length > 0) {
@@ -5748,16 +12893,16 @@ proto.watchclub.ListUserClubsRequest.serializeBinaryToWriter = function(message,
  * optional string user_id = 1;
  * @return {string}
  */
-proto.watchclub.ListUserClubsRequest.prototype.getUserId = function() {
+proto.watchclub.ListAppPasswordsRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.ListUserClubsRequest} returns this
+ * @return {!proto.watchclub.ListAppPasswordsRequest} returns this
  */
-proto.watchclub.ListUserClubsRequest.prototype.setUserId = function(value) {
+proto.watchclub.ListAppPasswordsRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -5768,7 +12913,7 @@ proto.watchclub.ListUserClubsRequest.prototype.setUserId = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.watchclub.ListUserClubsResponse.repeatedFields_ = [1];
+proto.watchclub.ListAppPasswordsResponse.repeatedFields_ = [1];
 
 
 
@@ -5785,8 +12930,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.ListUserClubsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.ListUserClubsResponse.toObject(opt_includeInstance, this);
+proto.watchclub.ListAppPasswordsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.ListAppPasswordsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5795,14 +12940,14 @@ proto.watchclub.ListUserClubsResponse.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.ListUserClubsResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.ListAppPasswordsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ListUserClubsResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.ListAppPasswordsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubsList: jspb.Message.toObjectList(msg.getClubsList(),
-    proto.watchclub.Club.toObject, includeInstance)
+    appPasswordsList: jspb.Message.toObjectList(msg.getAppPasswordsList(),
+    proto.watchclub.AppPassword.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5816,23 +12961,23 @@ proto.watchclub.ListUserClubsResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.ListUserClubsResponse}
+ * @return {!proto.watchclub.ListAppPasswordsResponse}
  */
-proto.watchclub.ListUserClubsResponse.deserializeBinary = function(bytes) {
+proto.watchclub.ListAppPasswordsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.ListUserClubsResponse;
-  return proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.ListAppPasswordsResponse;
+  return proto.watchclub.ListAppPasswordsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.ListUserClubsResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.ListAppPasswordsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.ListUserClubsResponse}
+ * @return {!proto.watchclub.ListAppPasswordsResponse}
  */
-proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.ListAppPasswordsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5840,9 +12985,9 @@ proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.watchclub.Club;
-      reader.readMessage(value,proto.watchclub.Club.deserializeBinaryFromReader);
-      msg.addClubs(value);
+      var value = new proto.watchclub.AppPassword;
+      reader.readMessage(value,proto.watchclub.AppPassword.deserializeBinaryFromReader);
+      msg.addAppPasswords(value);
       break;
     default:
       reader.skipField();
@@ -5857,9 +13002,9 @@ proto.watchclub.ListUserClubsResponse.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.ListUserClubsResponse.prototype.serializeBinary = function() {
+proto.watchclub.ListAppPasswordsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.ListUserClubsResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.ListAppPasswordsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5867,58 +13012,58 @@ proto.watchclub.ListUserClubsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.ListUserClubsResponse} message
+ * @param {!proto.watchclub.ListAppPasswordsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.ListUserClubsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.ListAppPasswordsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubsList();
+  f = message.getAppPasswordsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.watchclub.Club.serializeBinaryToWriter
+      proto.watchclub.AppPassword.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Club clubs = 1;
- * @return {!Array<!proto.watchclub.Club>}
+ * repeated AppPassword app_passwords = 1;
+ * @return {!Array<!proto.watchclub.AppPassword>}
  */
-proto.watchclub.ListUserClubsResponse.prototype.getClubsList = function() {
-  return /** @type{!Array<!proto.watchclub.Club>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.Club, 1));
+proto.watchclub.ListAppPasswordsResponse.prototype.getAppPasswordsList = function() {
+  return /** @type{!Array<!proto.watchclub.AppPassword>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.watchclub.AppPassword, 1));
 };
 
 
 /**
- * @param {!Array<!proto.watchclub.Club>} value
- * @return {!proto.watchclub.ListUserClubsResponse} returns this
+ * @param {!Array<!proto.watchclub.AppPassword>} value
+ * @return {!proto.watchclub.ListAppPasswordsResponse} returns this
 */
-proto.watchclub.ListUserClubsResponse.prototype.setClubsList = function(value) {
+proto.watchclub.ListAppPasswordsResponse.prototype.setAppPasswordsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.watchclub.Club=} opt_value
+ * @param {!proto.watchclub.AppPassword=} opt_value
  * @param {number=} opt_index
- * @return {!proto.watchclub.Club}
+ * @return {!proto.watchclub.AppPassword}
  */
-proto.watchclub.ListUserClubsResponse.prototype.addClubs = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.Club, opt_index);
+proto.watchclub.ListAppPasswordsResponse.prototype.addAppPasswords = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.watchclub.AppPassword, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.watchclub.ListUserClubsResponse} returns this
+ * @return {!proto.watchclub.ListAppPasswordsResponse} returns this
  */
-proto.watchclub.ListUserClubsResponse.prototype.clearClubsList = function() {
-  return this.setClubsList([]);
+proto.watchclub.ListAppPasswordsResponse.prototype.clearAppPasswordsList = function() {
+  return this.setAppPasswordsList([]);
 };
 
 
@@ -5938,8 +13083,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.DeleteClubRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.DeleteClubRequest.toObject(opt_includeInstance, this);
+proto.watchclub.DeleteAppPasswordRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteAppPasswordRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5948,13 +13093,14 @@ proto.watchclub.DeleteClubRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.DeleteClubRequest} msg The msg instance to transform.
+ * @param {!proto.watchclub.DeleteAppPasswordRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeleteClubRequest.toObject = function(includeInstance, msg) {
+proto.watchclub.DeleteAppPasswordRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    clubId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    userId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    appPasswordId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5968,23 +13114,23 @@ proto.watchclub.DeleteClubRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.DeleteClubRequest}
+ * @return {!proto.watchclub.DeleteAppPasswordRequest}
  */
-proto.watchclub.DeleteClubRequest.deserializeBinary = function(bytes) {
+proto.watchclub.DeleteAppPasswordRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.DeleteClubRequest;
-  return proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.DeleteAppPasswordRequest;
+  return proto.watchclub.DeleteAppPasswordRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.DeleteClubRequest} msg The message object to deserialize into.
+ * @param {!proto.watchclub.DeleteAppPasswordRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.DeleteClubRequest}
+ * @return {!proto.watchclub.DeleteAppPasswordRequest}
  */
-proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.DeleteAppPasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5993,7 +13139,11 @@ proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClubId(value);
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAppPasswordId(value);
       break;
     default:
       reader.skipField();
@@ -6008,9 +13158,9 @@ proto.watchclub.DeleteClubRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.DeleteClubRequest.prototype.serializeBinary = function() {
+proto.watchclub.DeleteAppPasswordRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.DeleteClubRequest.serializeBinaryToWriter(this, writer);
+  proto.watchclub.DeleteAppPasswordRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6018,40 +13168,65 @@ proto.watchclub.DeleteClubRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.DeleteClubRequest} message
+ * @param {!proto.watchclub.DeleteAppPasswordRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeleteClubRequest.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.DeleteAppPasswordRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClubId();
+  f = message.getUserId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getAppPasswordId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string club_id = 1;
+ * optional string user_id = 1;
  * @return {string}
  */
-proto.watchclub.DeleteClubRequest.prototype.getClubId = function() {
+proto.watchclub.DeleteAppPasswordRequest.prototype.getUserId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.watchclub.DeleteClubRequest} returns this
+ * @return {!proto.watchclub.DeleteAppPasswordRequest} returns this
  */
-proto.watchclub.DeleteClubRequest.prototype.setClubId = function(value) {
+proto.watchclub.DeleteAppPasswordRequest.prototype.setUserId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string app_password_id = 2;
+ * @return {string}
+ */
+proto.watchclub.DeleteAppPasswordRequest.prototype.getAppPasswordId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.watchclub.DeleteAppPasswordRequest} returns this
+ */
+proto.watchclub.DeleteAppPasswordRequest.prototype.setAppPasswordId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -6068,8 +13243,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.watchclub.DeleteClubResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.watchclub.DeleteClubResponse.toObject(opt_includeInstance, this);
+proto.watchclub.DeleteAppPasswordResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.watchclub.DeleteAppPasswordResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6078,11 +13253,11 @@ proto.watchclub.DeleteClubResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.watchclub.DeleteClubResponse} msg The msg instance to transform.
+ * @param {!proto.watchclub.DeleteAppPasswordResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeleteClubResponse.toObject = function(includeInstance, msg) {
+proto.watchclub.DeleteAppPasswordResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
@@ -6098,23 +13273,23 @@ proto.watchclub.DeleteClubResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.watchclub.DeleteClubResponse}
+ * @return {!proto.watchclub.DeleteAppPasswordResponse}
  */
-proto.watchclub.DeleteClubResponse.deserializeBinary = function(bytes) {
+proto.watchclub.DeleteAppPasswordResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.watchclub.DeleteClubResponse;
-  return proto.watchclub.DeleteClubResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.watchclub.DeleteAppPasswordResponse;
+  return proto.watchclub.DeleteAppPasswordResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.watchclub.DeleteClubResponse} msg The message object to deserialize into.
+ * @param {!proto.watchclub.DeleteAppPasswordResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.watchclub.DeleteClubResponse}
+ * @return {!proto.watchclub.DeleteAppPasswordResponse}
  */
-proto.watchclub.DeleteClubResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.watchclub.DeleteAppPasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6138,9 +13313,9 @@ proto.watchclub.DeleteClubResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.watchclub.DeleteClubResponse.prototype.serializeBinary = function() {
+proto.watchclub.DeleteAppPasswordResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.watchclub.DeleteClubResponse.serializeBinaryToWriter(this, writer);
+  proto.watchclub.DeleteAppPasswordResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6148,11 +13323,11 @@ proto.watchclub.DeleteClubResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.watchclub.DeleteClubResponse} message
+ * @param {!proto.watchclub.DeleteAppPasswordResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.watchclub.DeleteClubResponse.serializeBinaryToWriter = function(message, writer) {
+proto.watchclub.DeleteAppPasswordResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getSuccess();
   if (f) {
@@ -6168,16 +13343,16 @@ proto.watchclub.DeleteClubResponse.serializeBinaryToWriter = function(message, w
  * optional bool success = 1;
  * @return {boolean}
  */
-proto.watchclub.DeleteClubResponse.prototype.getSuccess = function() {
+proto.watchclub.DeleteAppPasswordResponse.prototype.getSuccess = function() {
   return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.watchclub.DeleteClubResponse} returns this
+ * @return {!proto.watchclub.DeleteAppPasswordResponse} returns this
  */
-proto.watchclub.DeleteClubResponse.prototype.setSuccess = function(value) {
+proto.watchclub.DeleteAppPasswordResponse.prototype.setSuccess = function(value) {
   return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
@@ -6192,4 +13367,29 @@ proto.watchclub.ScheduleIntervalUnit = {
   SCHEDULE_INTERVAL_UNIT_MONTHS: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.watchclub.NotificationType = {
+  NOTIFICATION_TYPE_UNSPECIFIED: 0,
+  NOTIFICATION_TYPE_CLUB_STARTED: 1,
+  NOTIFICATION_TYPE_REMINDERS: 2,
+  NOTIFICATION_TYPE_SCHEDULE_CHANGES: 3,
+  NOTIFICATION_TYPE_DIGESTS: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.watchclub.Weekday = {
+  WEEKDAY_UNSPECIFIED: 0,
+  WEEKDAY_SUNDAY: 1,
+  WEEKDAY_MONDAY: 2,
+  WEEKDAY_TUESDAY: 3,
+  WEEKDAY_WEDNESDAY: 4,
+  WEEKDAY_THURSDAY: 5,
+  WEEKDAY_FRIDAY: 6,
+  WEEKDAY_SATURDAY: 7
+};
+
 goog.object.extend(exports, proto.watchclub);
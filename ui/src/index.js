@@ -14,7 +14,11 @@ const {
     GetScheduledPicksRequest,
     SendLoginEmailRequest,
     GetClubCalendarRequest,
-    ListUserClubsRequest
+    ListUserClubsRequest,
+    ListDeletedClubsRequest,
+    RestoreClubRequest,
+    ListDeletedPicksRequest,
+    RestorePickRequest
 } = require('./api/v1_pb.js');
 
 const {Timestamp} = require('google-protobuf/google/protobuf/timestamp_pb.js');
@@ -62,6 +66,24 @@ function escapeHtml(text) {
     return div.innerHTML;
 }
 
+// Calls a paged list method until its last page, then calls back with the items of every page
+function listAllPages(method, request, getItems, callback, items = []) {
+    method.call(client, request, {}, (err, response) => {
+        if (err) {
+            callback(err, null);
+            return;
+        }
+        items.push(...getItems(response));
+        const nextPageToken = response.getNextPageToken();
+        if (!nextPageToken) {
+            callback(null, items);
+            return;
+        }
+        request.setPageToken(nextPageToken);
+        listAllPages(method, request, getItems, callback, items);
+    });
+}
+
 // ===== ROUTER =====
 const router = {
     routes: {},
@@ -163,6 +185,7 @@ function renderHomePage() {
                 </div>
 
                 <div id="userClubsList">Loading your clubs...</div>
+                <div id="deletedClubsList"></div>
 
                 <div class="actions-grid">
                     <div class="card action-card">
@@ -208,7 +231,7 @@ function renderHomePage() {
         const request = new ListUserClubsRequest();
         request.setUserId(state.currentUser.id);
 
-        client.listUserClubs(request, {}, (err, response) => {
+        listAllPages(client.listUserClubs, request, response => response.getClubsList(), (err, clubs) => {
             const clubsList = document.getElementById('userClubsList');
             if (!clubsList) return; // User navigated away
 
@@ -217,8 +240,6 @@ function renderHomePage() {
                 return;
             }
 
-            const clubs = response.getClubsList();
-
             if (clubs.length === 0) {
                 clubsList.innerHTML = '';
             } else {
@@ -239,9 +260,48 @@ function renderHomePage() {
                 `;
             }
         });
+
+        loadDeletedClubs();
     }
 }
 
+// Lists the user's deleted clubs on the home page, so they can be restored
+function loadDeletedClubs() {
+    const request = new ListDeletedClubsRequest();
+    request.setUserId(state.currentUser.id);
+
+    client.listDeletedClubs(request, {}, (err, response) => {
+        const deletedList = document.getElementById('deletedClubsList');
+        if (!deletedList) return; // User navigated away
+
+        if (err) {
+            deletedList.innerHTML = `<p class="error-message">Error loading deleted clubs: ${err.message}</p>`;
+            return;
+        }
+
+        const clubs = response.getClubsList();
+        if (clubs.length === 0) {
+            deletedList.innerHTML = '';
+            return;
+        }
+        deletedList.innerHTML = `
+            <div class="card">
+                <h2>Recently Deleted</h2>
+                <p style="color: #666;">Deleted clubs can be restored for ${response.getRetentionDays()} days.</p>
+                <div class="club-list">
+                    ${clubs.map(club => `
+                        <div class="club-item">
+                            <span><strong>${escapeHtml(club.getName())}</strong> deleted ${formatDate(club.getDeletedAt())}</span>
+                            <button onclick="restoreClubAction('${club.getId()}')" class="btn-secondary">Restore</button>
+                        </div>
+                    `).join('')}
+                </div>
+                <div id="restoreClubError" class="error-message"></div>
+            </div>
+        `;
+    });
+}
+
 // Join Club Page
 function renderJoinPage(params) {
     const content = document.getElementById('app-content');
@@ -513,6 +573,8 @@ function renderClubDetailPage(params) {
                     ` : `
                         <p style="color: #666; margin-top: 1rem;">No picks yet.</p>
                     `}
+
+                    <div id="deletedPicksList"></div>
                 </div>
             ` : ''}
 
@@ -528,7 +590,7 @@ function renderClubDetailPage(params) {
 
             <div class="card" style="margin-top: 2rem; border: 1px solid #ffebee;">
                 <h3 style="color: #d32f2f;">Danger Zone</h3>
-                <p style="color: #666; margin-bottom: 1rem;">Deleting this club will permanently remove all picks, schedules, and member associations. This action cannot be undone.</p>
+                <p style="color: #666; margin-bottom: 1rem;">Deleting this club will remove all picks, schedules, and member associations. Any member can restore it from their home page until it's permanently deleted.</p>
                 <button onclick="deleteClubAction('${clubId}')" class="btn-danger">Delete Club</button>
                 <div id="deleteClubError" class="error-message"></div>
             </div>
@@ -536,10 +598,53 @@ function renderClubDetailPage(params) {
 
         if (club.getStarted()) {
             loadSchedule(clubId, club, members);
+        } else {
+            loadDeletedPicks(clubId);
         }
     });
 }
 
+// Lists the user's deleted picks in a club that hasn't started, so they can be restored
+function loadDeletedPicks(clubId) {
+    const request = new ListDeletedPicksRequest();
+    request.setClubId(clubId);
+    request.setUserId(state.currentUser.id);
+
+    client.listDeletedPicks(request, {}, (err, response) => {
+        const deletedList = document.getElementById('deletedPicksList');
+        if (!deletedList) return; // User navigated away
+
+        if (err) {
+            deletedList.innerHTML = `<p class="error-message">Error loading deleted picks: ${err.message}</p>`;
+            return;
+        }
+
+        const picks = response.getPicksList();
+        if (picks.length === 0) {
+            deletedList.innerHTML = '';
+            return;
+        }
+        deletedList.innerHTML = `
+            <div style="margin-top: 1rem; padding-top: 1rem; border-top: 2px solid #e0e0e0;">
+                <h4>Your Deleted Picks</h4>
+                <p style="color: #666;">Deleted picks can be restored for ${response.getRetentionDays()} days.</p>
+                <div class="pick-list">
+                    ${picks.map(p => `
+                        <div class="pick-item">
+                            <div class="pick-content">
+                                <strong><i>${escapeHtml(p.getTitle())}</i></strong> ${p.getYear() ? `(${p.getYear()})` : ''}
+                                <span class="pick-author">deleted ${formatDate(p.getDeletedAt())}</span>
+                            </div>
+                            <button onclick="restorePickAction('${clubId}', '${p.getId()}')" class="btn-secondary">Restore</button>
+                        </div>
+                    `).join('')}
+                </div>
+                <div id="restorePickError" class="error-message"></div>
+            </div>
+        `;
+    });
+}
+
 // Add Pick Page
 function renderAddPickPage(params) {
     const content = document.getElementById('app-content');
@@ -880,7 +985,7 @@ function startClubAction(clubId) {
 }
 
 function deleteClubAction(clubId) {
-    if (!confirm('Are you sure you want to delete this club? This action cannot be undone and will remove all picks, schedules, and member associations.')) {
+    if (!confirm('Are you sure you want to delete this club? This will remove all picks, schedules, and member associations until a member restores it.')) {
         return;
     }
 
@@ -920,6 +1025,42 @@ function deletePickAction(clubId, pickId) {
     });
 }
 
+function restoreClubAction(clubId) {
+    const errorEl = document.getElementById('restoreClubError');
+    const request = new RestoreClubRequest();
+    request.setClubId(clubId);
+    request.setUserId(state.currentUser.id);
+
+    client.restoreClub(request, {}, (err) => {
+        if (err) {
+            errorEl.textContent = `Error: ${err.message}`;
+            errorEl.style.display = 'block';
+            return;
+        }
+
+        router.navigate(`/club/${clubId}`);
+    });
+}
+
+function restorePickAction(clubId, pickId) {
+    const errorEl = document.getElementById('restorePickError');
+    const request = new RestorePickRequest();
+    request.setPickId(pickId);
+    request.setClubId(clubId);
+    request.setUserId(state.currentUser.id);
+
+    client.restorePick(request, {}, (err) => {
+        if (err) {
+            errorEl.textContent = `Error: ${err.message}`;
+            errorEl.style.display = 'block';
+            return;
+        }
+
+        // Refresh the club detail page
+        renderClubDetailPage({clubId});
+    });
+}
+
 function downloadCalendar(clubId) {
     const request = new GetClubCalendarRequest();
     request.setClubId(clubId);
@@ -947,15 +1088,13 @@ function loadSchedule(clubId, club, members) {
     const request = new GetScheduledPicksRequest();
     request.setClubId(clubId);
 
-    client.getScheduledPicks(request, {}, (err, response) => {
+    listAllPages(client.getScheduledPicks, request, response => response.getAssignmentsList(), (err, assignments) => {
         const scheduleContent = document.getElementById('scheduleContent');
         if (err) {
             scheduleContent.innerHTML = `<p class="error-message">Error loading schedule: ${err.message}</p>`;
             return;
         }
 
-        const assignments = response.getAssignmentsList();
-
         scheduleContent.innerHTML = `
             <div class="schedule-list">
                 ${assignments.map(a => {
@@ -1075,6 +1214,8 @@ window.addPickAction = addPickAction;
 window.startClubAction = startClubAction;
 window.deleteClubAction = deleteClubAction;
 window.deletePickAction = deletePickAction;
+window.restoreClubAction = restoreClubAction;
+window.restorePickAction = restorePickAction;
 window.downloadCalendar = downloadCalendar;
 window.copyShareLink = copyShareLink;
 window.logout = logout;